@@ -0,0 +1,180 @@
+package diff
+
+import (
+	"strings"
+)
+
+// ANSI escape sequences used by the terminal renderers.
+const (
+	ansiReset   = "\x1b[0m"
+	ansiBold    = "\x1b[1m"
+	ansiReverse = "\x1b[7m"
+	ansiRed     = "\x1b[31m"
+	ansiGreen   = "\x1b[32m"
+)
+
+// ANSIOptions controls the output of RenderANSI and RenderUnifiedANSI.
+type ANSIOptions struct {
+	// NoColor disables escape sequences. Changed words of a word diff
+	// are then marked as [-deleted-] and {+inserted+}, and a line diff
+	// is rendered exactly as by ToUnified.
+	NoColor bool
+	// Background shows changed words on a red or green background
+	// rather than in red or green text. In a line diff, it highlights
+	// the changed words within each run of replaced lines.
+	Background bool
+	// Elision replaces each elided stretch of a word diff.
+	Elision string
+}
+
+// RenderANSI renders the word diff for a terminal, with deleted words
+// in red and inserted words in green.
+func RenderANSI(d *WordDiff, opts ANSIOptions) string {
+	return d.Format(ansiFormatter{opts}, opts.Elision)
+}
+
+// ansiFormatter is the Formatter used by RenderANSI.
+type ansiFormatter struct{ opts ANSIOptions }
+
+func (f ansiFormatter) Equal(s Segment) string { return s.Text }
+
+func (f ansiFormatter) Delete(s Segment) string {
+	if f.opts.NoColor {
+		return "[-" + s.Text + "-]"
+	}
+	return f.color(ansiRed) + s.Text + ansiReset
+}
+
+func (f ansiFormatter) Insert(s Segment) string {
+	if f.opts.NoColor {
+		return "{+" + s.Text + "+}"
+	}
+	return f.color(ansiGreen) + s.Text + ansiReset
+}
+
+func (f ansiFormatter) Replace(deleted, inserted Segment) string {
+	return f.Delete(deleted) + f.Insert(inserted)
+}
+
+func (f ansiFormatter) color(fg string) string {
+	if f.opts.Background {
+		return fg + ansiReverse
+	}
+	return fg
+}
+
+// RenderUnifiedANSI is like ToUnified, but colours the unified diff for
+// a terminal: headers are bold, deleted lines red and inserted lines
+// green. The elision option is not used.
+func RenderUnifiedANSI(oldLabel, newLabel, content string, edits []Edit, contextLines int, opts ANSIOptions) (string, error) {
+	p, err := toPatch(oldLabel, newLabel, content, edits, contextLines)
+	if err != nil {
+		return "", err
+	}
+	if opts.NoColor {
+		return p.String(), nil
+	}
+	if len(p.hunks) == 0 {
+		return "", nil
+	}
+
+	var b strings.Builder
+	b.WriteString(ansiBold + "--- " + p.from + ansiReset + "\n")
+	b.WriteString(ansiBold + "+++ " + p.to + ansiReset + "\n")
+	for _, h := range p.hunks {
+		b.WriteString(ansiBold + h.header() + ansiReset + "\n")
+		for i := 0; i < len(h.lines); {
+			if h.lines[i].kind == Equal {
+				b.WriteString(lineString(h.lines[i]))
+				i++
+				continue
+			}
+
+			// A change is a run of deletions followed by a run of insertions.
+			j := i
+			for j < len(h.lines) && h.lines[j].kind == Delete {
+				j++
+			}
+			k := j
+			for k < len(h.lines) && h.lines[k].kind == Insert {
+				k++
+			}
+			writeANSIChange(&b, h.lines[i:j], h.lines[j:k], opts.Background)
+			i = k
+		}
+	}
+	return b.String(), nil
+}
+
+// writeANSIChange writes the replacement of the deleted lines by the
+// inserted ones. If highlight is set and there are lines on both sides,
+// the words that differ between them are highlighted.
+func writeANSIChange(b *strings.Builder, deleted, inserted []line, highlight bool) {
+	before, after := joinLines(deleted), joinLines(inserted)
+	var segs []Segment
+	if highlight && before != "" && after != "" {
+		d, err := ToWordDiff(before, Strings(before, after), Whitespace, FullContext)
+		if err != nil {
+			panic(err) // can't happen: edits are consistent
+		}
+		segs = d.Segments
+	}
+	writeANSISide(b, "-", ansiRed, before, segs, Delete)
+	writeANSISide(b, "+", ansiGreen, after, segs, Insert)
+}
+
+func joinLines(lines []line) string {
+	var b strings.Builder
+	for _, l := range lines {
+		b.WriteString(l.content)
+	}
+	return b.String()
+}
+
+// writeANSISide writes text as prefixed lines in the given colour.
+// Segments of the given kind, if any, are highlighted within them.
+func writeANSISide(b *strings.Builder, prefix, color, text string, segs []Segment, kind Kind) {
+	if text == "" {
+		return
+	}
+	w := ansiLineWriter{b: b, prefix: prefix, color: color, atStart: true}
+	if segs == nil {
+		w.write(text, false)
+	}
+	for _, s := range segs {
+		if s.Kind == kind || s.Kind == Equal {
+			w.write(s.Text, s.Kind == kind)
+		}
+	}
+	if !w.atStart {
+		b.WriteString(ansiReset + "\n\\ No newline at end of file\n")
+	}
+}
+
+// An ansiLineWriter writes text as coloured, prefixed lines,
+// ending each line with a reset so that colours never span lines.
+type ansiLineWriter struct {
+	b             *strings.Builder
+	prefix, color string
+	atStart       bool // at the start of a line
+}
+
+func (w *ansiLineWriter) write(text string, highlight bool) {
+	for text != "" {
+		if w.atStart {
+			w.b.WriteString(w.color + w.prefix)
+			w.atStart = false
+		}
+		part, rest, nl := strings.Cut(text, "\n")
+		if highlight && part != "" {
+			w.b.WriteString(ansiReverse + part + ansiReset + w.color)
+		} else {
+			w.b.WriteString(part)
+		}
+		if nl {
+			w.b.WriteString(ansiReset + "\n")
+			w.atStart = true
+		}
+		text = rest
+	}
+}
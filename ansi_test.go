@@ -0,0 +1,53 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderANSI(t *testing.T) {
+	before := "the red fox jumped"
+	after := "the green fox jumped"
+	d, err := ToWordDiff(before, Strings(before, after), Spaces, FullContext)
+	require.NoError(t, err)
+
+	tests := []struct {
+		opts   ANSIOptions
+		expect string
+	}{
+		{ANSIOptions{}, "the \x1b[31mred\x1b[0m\x1b[32mgreen\x1b[0m fox jumped"},
+		{ANSIOptions{Background: true}, "the \x1b[31m\x1b[7mred\x1b[0m\x1b[32m\x1b[7mgreen\x1b[0m fox jumped"},
+		{ANSIOptions{NoColor: true}, "the [-red-]{+green+} fox jumped"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expect, RenderANSI(d, test.opts), "%+v", test.opts)
+	}
+}
+
+func TestRenderUnifiedANSI(t *testing.T) {
+	before := "a\nthe red fox\nb\n"
+	after := "a\nthe green fox\nb\nc"
+	edits := Strings(before, after)
+
+	got, err := RenderUnifiedANSI("old", "new", before, edits, 1, ANSIOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "\x1b[1m--- old\x1b[0m\n\x1b[1m+++ new\x1b[0m\n"+
+		"\x1b[1m@@ -1,3 +1,4 @@\x1b[0m\n"+
+		" a\n"+
+		"\x1b[31m-the red fox\x1b[0m\n"+
+		"\x1b[32m+the green fox\x1b[0m\n"+
+		" b\n"+
+		"\x1b[32m+c\x1b[0m\n\\ No newline at end of file\n", got)
+
+	got, err = RenderUnifiedANSI("old", "new", before, edits, 1, ANSIOptions{Background: true})
+	require.NoError(t, err)
+	require.Contains(t, got, "\x1b[31m-the \x1b[7mred\x1b[0m\x1b[31m fox\x1b[0m\n")
+	require.Contains(t, got, "\x1b[32m+the \x1b[7mgreen\x1b[0m\x1b[32m fox\x1b[0m\n")
+
+	got, err = RenderUnifiedANSI("old", "new", before, edits, 1, ANSIOptions{NoColor: true})
+	require.NoError(t, err)
+	want, err := ToUnified("old", "new", before, edits, 1)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,504 @@
+package diff
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The cleanups below are ports of those of Neil Fraser's
+// diff-match-patch, adapted to edits and to respect rune boundaries.
+
+// DefaultEditCost is the cost of an edit, in runes of unchanged text,
+// used by CleanupEfficiency when callers have no preference.
+const DefaultEditCost = 4
+
+// CleanupSemantic returns edits equivalent to edits on src that are
+// easier for people to read. Short stretches of unchanged text that are
+// no longer than the changes either side of them are absorbed into a
+// single replacement, so that the edits from "the quick brown fox" to
+// "the slow green turtle" read as one replacement rather than a
+// scattering of fragments. Changes are then aligned with word and line
+// boundaries where possible.
+// It returns an error if the edits are inconsistent; see Apply.
+func CleanupSemantic(src string, edits []Edit) ([]Edit, error) {
+	edits, _, err := validate(src, edits)
+	if err != nil {
+		return nil, err
+	}
+	return opsEdits(cleanupSemantic(editOps(src, edits))), nil
+}
+
+// CleanupEfficiency returns edits equivalent to edits on src with fewer
+// separate changes, for output that is processed by machines. A stretch
+// of unchanged text is absorbed into the changes around it when it is
+// shorter than editCost runes and there are changes on enough sides of
+// it that keeping it costs more than it saves. DefaultEditCost is a
+// typical editCost.
+// It returns an error if the edits are inconsistent; see Apply.
+func CleanupEfficiency(src string, edits []Edit, editCost int) ([]Edit, error) {
+	edits, _, err := validate(src, edits)
+	if err != nil {
+		return nil, err
+	}
+	return opsEdits(cleanupEfficiency(editOps(src, edits), editCost)), nil
+}
+
+// An op is one step of a diff: text that is kept, deleted or inserted.
+type op struct {
+	kind Kind
+	text string
+}
+
+// editOps returns the ops of the sorted, non-overlapping edits on src.
+func editOps(src string, edits []Edit) []op {
+	var ops []op
+	last := 0
+	for _, edit := range edits {
+		if last < edit.Start {
+			ops = append(ops, op{Equal, src[last:edit.Start]})
+		}
+		if edit.Start < edit.End {
+			ops = append(ops, op{Delete, src[edit.Start:edit.End]})
+		}
+		if edit.New != "" {
+			ops = append(ops, op{Insert, edit.New})
+		}
+		last = edit.End
+	}
+	if last < len(src) {
+		ops = append(ops, op{Equal, src[last:]})
+	}
+	return ops
+}
+
+// opsEdits returns the edits that perform ops, merging adjacent changes.
+func opsEdits(ops []op) []Edit {
+	var edits []Edit
+	add := func(start, end int, text string) {
+		if start == end && text == "" {
+			return // an empty op left by the cleanups
+		}
+		if n := len(edits); n > 0 && edits[n-1].End == start {
+			edits[n-1].End = end
+			edits[n-1].New += text
+			return
+		}
+		edits = append(edits, Edit{start, end, text})
+	}
+	offset := 0
+	for _, o := range ops {
+		switch o.kind {
+		case Equal:
+			offset += len(o.text)
+		case Delete:
+			add(offset, offset+len(o.text), "")
+			offset += len(o.text)
+		case Insert:
+			add(offset, offset, o.text)
+		}
+	}
+	return edits
+}
+
+// insertOps inserts ins into ops before index i.
+func insertOps(ops []op, i int, ins ...op) []op {
+	return append(ops[:i], append(ins, ops[i:]...)...)
+}
+
+// removeOps removes n ops from ops starting at index i.
+func removeOps(ops []op, i, n int) []op {
+	return append(ops[:i], ops[i+n:]...)
+}
+
+// cleanupSemantic eliminates equalities that are semantically trivial,
+// then shifts the remaining edits to word boundaries and extracts
+// overlaps between deletions and insertions.
+func cleanupSemantic(ops []op) []op {
+	changes := false
+	var equalities []int // stack of indexes of equalities
+	var lastEquality string
+	// Runes changed before and after the last equality.
+	var inserted1, deleted1, inserted2, deleted2 int
+	for i := 0; i < len(ops); i++ {
+		if ops[i].kind == Equal {
+			equalities = append(equalities, i)
+			inserted1, deleted1 = inserted2, deleted2
+			inserted2, deleted2 = 0, 0
+			lastEquality = ops[i].text
+			continue
+		}
+		if ops[i].kind == Insert {
+			inserted2 += utf8.RuneCountInString(ops[i].text)
+		} else {
+			deleted2 += utf8.RuneCountInString(ops[i].text)
+		}
+		// Eliminate an equality no longer than the edits on both sides.
+		n := utf8.RuneCountInString(lastEquality)
+		if n > 0 && n <= max(inserted1, deleted1) && n <= max(inserted2, deleted2) {
+			j := equalities[len(equalities)-1]
+			ops = insertOps(ops, j, op{Delete, lastEquality})
+			ops[j+1].kind = Insert
+			// Throw away the equality, and reconsider the one before it.
+			equalities = equalities[:len(equalities)-1]
+			if len(equalities) > 0 {
+				equalities = equalities[:len(equalities)-1]
+			}
+			i = -1
+			if len(equalities) > 0 {
+				i = equalities[len(equalities)-1]
+			}
+			inserted1, deleted1, inserted2, deleted2 = 0, 0, 0, 0
+			lastEquality = ""
+			changes = true
+		}
+	}
+	if changes {
+		ops = cleanupMerge(ops)
+	}
+	ops = cleanupSemanticLossless(ops)
+
+	// Extract overlaps between a deletion and the following insertion
+	// that are at least half as long as either:
+	// <del>abcxxx</del><ins>xxxdef</ins> -> <del>abc</del>xxx<ins>def</ins>
+	// <del>xxxabc</del><ins>defxxx</ins> -> <ins>def</ins>xxx<del>abc</del>
+	for i := 1; i < len(ops); i++ {
+		if ops[i-1].kind != Delete || ops[i].kind != Insert {
+			continue
+		}
+		deletion, insertion := ops[i-1].text, ops[i].text
+		dn := utf8.RuneCountInString(deletion)
+		in := utf8.RuneCountInString(insertion)
+		overlap1 := commonOverlap(deletion, insertion)
+		overlap2 := commonOverlap(insertion, deletion)
+		if overlap1 >= overlap2 {
+			if n := utf8.RuneCountInString(insertion[:overlap1]); 2*n >= dn || 2*n >= in {
+				ops = insertOps(ops, i, op{Equal, insertion[:overlap1]})
+				ops[i-1].text = deletion[:len(deletion)-overlap1]
+				ops[i+1].text = insertion[overlap1:]
+				i++
+			}
+		} else {
+			if n := utf8.RuneCountInString(deletion[:overlap2]); 2*n >= dn || 2*n >= in {
+				ops = insertOps(ops, i, op{Equal, deletion[:overlap2]})
+				ops[i-1] = op{Insert, insertion[:len(insertion)-overlap2]}
+				ops[i+1] = op{Delete, deletion[overlap2:]}
+				i++
+			}
+		}
+		i++
+	}
+	return ops
+}
+
+// cleanupSemanticLossless shifts each single edit surrounded by
+// equalities sideways so that it is aligned with word boundaries:
+// "The c<ins>at c</ins>ame." becomes "The <ins>cat </ins>came."
+func cleanupSemanticLossless(ops []op) []op {
+	for i := 1; i < len(ops)-1; i++ {
+		if ops[i-1].kind != Equal || ops[i+1].kind != Equal {
+			continue
+		}
+		equality1, edit, equality2 := ops[i-1].text, ops[i].text, ops[i+1].text
+
+		// First, shift the edit as far left as possible.
+		if n := commonSuffix(equality1, edit); n > 0 {
+			common := edit[len(edit)-n:]
+			equality1 = equality1[:len(equality1)-n]
+			edit = common + edit[:len(edit)-n]
+			equality2 = common + equality2
+		}
+
+		// Second, step rune by rune right, looking for the best fit.
+		best1, bestEdit, best2 := equality1, edit, equality2
+		bestScore := semanticScore(equality1, edit) + semanticScore(edit, equality2)
+		for edit != "" && equality2 != "" {
+			_, size := utf8.DecodeRuneInString(edit)
+			if len(equality2) < size || edit[:size] != equality2[:size] {
+				break
+			}
+			equality1 += edit[:size]
+			edit = edit[size:] + equality2[:size]
+			equality2 = equality2[size:]
+			// The >= encourages trailing rather than leading white space on edits.
+			if score := semanticScore(equality1, edit) + semanticScore(edit, equality2); score >= bestScore {
+				bestScore = score
+				best1, bestEdit, best2 = equality1, edit, equality2
+			}
+		}
+
+		if ops[i-1].text != best1 {
+			if best1 != "" {
+				ops[i-1].text = best1
+			} else {
+				ops = removeOps(ops, i-1, 1)
+				i--
+			}
+			ops[i].text = bestEdit
+			if best2 != "" {
+				ops[i+1].text = best2
+			} else {
+				ops = removeOps(ops, i+1, 1)
+				i--
+			}
+		}
+	}
+	return ops
+}
+
+// semanticScore scores how well the boundary between one and two falls
+// on a logical boundary, from 6 (best) to 0 (worst).
+func semanticScore(one, two string) int {
+	if one == "" || two == "" {
+		return 6 // edges are the best
+	}
+	r1, _ := utf8.DecodeLastRuneInString(one)
+	r2, _ := utf8.DecodeRuneInString(two)
+	nonAlnum1 := !unicode.IsLetter(r1) && !unicode.IsDigit(r1)
+	nonAlnum2 := !unicode.IsLetter(r2) && !unicode.IsDigit(r2)
+	space1 := nonAlnum1 && unicode.IsSpace(r1)
+	space2 := nonAlnum2 && unicode.IsSpace(r2)
+	lineBreak1 := space1 && (r1 == '\n' || r1 == '\r')
+	lineBreak2 := space2 && (r2 == '\n' || r2 == '\r')
+	blankLine1 := lineBreak1 && (strings.HasSuffix(one, "\n\n") || strings.HasSuffix(one, "\n\r\n"))
+	blankLine2 := lineBreak2 && blankLineStart(two)
+	switch {
+	case blankLine1 || blankLine2:
+		return 5
+	case lineBreak1 || lineBreak2:
+		return 4
+	case nonAlnum1 && !space1 && space2:
+		return 3 // end of sentence
+	case space1 || space2:
+		return 2
+	case nonAlnum1 || nonAlnum2:
+		return 1
+	}
+	return 0
+}
+
+// blankLineStart reports whether s starts with a blank line.
+func blankLineStart(s string) bool {
+	s = strings.TrimPrefix(s, "\r")
+	if !strings.HasPrefix(s, "\n") {
+		return false
+	}
+	s = strings.TrimPrefix(s[1:], "\r")
+	return strings.HasPrefix(s, "\n")
+}
+
+// cleanupEfficiency eliminates equalities that cost more to keep, as
+// separate edits of cost editCost, than to absorb into their neighbours.
+func cleanupEfficiency(ops []op, editCost int) []op {
+	changes := false
+	var equalities []int // stack of indexes of candidate equalities
+	var lastEquality string
+	// Whether there are insertions and deletions before and after the
+	// last equality.
+	var preIns, preDel, postIns, postDel bool
+	for i := 0; i < len(ops); i++ {
+		if ops[i].kind == Equal {
+			if utf8.RuneCountInString(ops[i].text) < editCost && (postIns || postDel) {
+				// Candidate found.
+				equalities = append(equalities, i)
+				preIns, preDel = postIns, postDel
+				lastEquality = ops[i].text
+			} else {
+				// Not a candidate, and can never become one.
+				equalities = nil
+				lastEquality = ""
+			}
+			postIns, postDel = false, false
+			continue
+		}
+		if ops[i].kind == Delete {
+			postDel = true
+		} else {
+			postIns = true
+		}
+
+		// Five types to be split:
+		// <ins>A</ins><del>B</del>XY<ins>C</ins><del>D</del>
+		// <ins>A</ins>X<ins>C</ins><del>D</del>
+		// <ins>A</ins><del>B</del>X<ins>C</ins>
+		// <ins>A</del>X<ins>C</ins><del>D</del>
+		// <ins>A</ins><del>B</del>X<del>C</del>
+		sides := 0
+		for _, b := range [...]bool{preIns, preDel, postIns, postDel} {
+			if b {
+				sides++
+			}
+		}
+		n := utf8.RuneCountInString(lastEquality)
+		if n > 0 && (sides == 4 || 2*n < editCost && sides == 3) {
+			j := equalities[len(equalities)-1]
+			ops = insertOps(ops, j, op{Delete, lastEquality})
+			ops[j+1].kind = Insert
+			equalities = equalities[:len(equalities)-1]
+			lastEquality = ""
+			if preIns && preDel {
+				// No changes made which could affect the previous entry.
+				postIns, postDel = true, true
+				equalities = nil
+			} else {
+				if len(equalities) > 0 {
+					equalities = equalities[:len(equalities)-1]
+				}
+				i = -1
+				if len(equalities) > 0 {
+					i = equalities[len(equalities)-1]
+				}
+				postIns, postDel = false, false
+			}
+			changes = true
+		}
+	}
+	if changes {
+		ops = cleanupMerge(ops)
+	}
+	return ops
+}
+
+// cleanupMerge merges adjacent ops of the same kind, factors out text
+// common to adjacent deletions and insertions, and shifts single edits
+// sideways to eliminate equalities: A<ins>BA</ins>C becomes <ins>AB</ins>AC.
+func cleanupMerge(ops []op) []op {
+	ops = append(ops, op{Equal, ""}) // sentinel
+	var deleted, inserted strings.Builder
+	ndeleted, ninserted := 0, 0
+	for i := 0; i < len(ops); {
+		switch ops[i].kind {
+		case Delete:
+			ndeleted++
+			deleted.WriteString(ops[i].text)
+			i++
+			continue
+		case Insert:
+			ninserted++
+			inserted.WriteString(ops[i].text)
+			i++
+			continue
+		}
+
+		// An equality: merge the preceding run of changes.
+		if ndeleted+ninserted > 1 {
+			del, ins := deleted.String(), inserted.String()
+			if ndeleted > 0 && ninserted > 0 {
+				// Factor out any common prefix.
+				if n := commonPrefix(ins, del); n > 0 {
+					if j := i - ndeleted - ninserted; j > 0 && ops[j-1].kind == Equal {
+						ops[j-1].text += ins[:n]
+					} else {
+						ops = insertOps(ops, 0, op{Equal, ins[:n]})
+						i++
+					}
+					ins, del = ins[n:], del[n:]
+				}
+				// Factor out any common suffix.
+				if n := commonSuffix(ins, del); n > 0 {
+					ops[i].text = ins[len(ins)-n:] + ops[i].text
+					ins, del = ins[:len(ins)-n], del[:len(del)-n]
+				}
+			}
+			// Replace the run by at most one deletion and one insertion.
+			start := i - ndeleted - ninserted
+			var merged []op
+			if del != "" {
+				merged = append(merged, op{Delete, del})
+			}
+			if ins != "" {
+				merged = append(merged, op{Insert, ins})
+			}
+			ops = insertOps(removeOps(ops, start, ndeleted+ninserted), start, merged...)
+			i = start + len(merged) // revisit the equality
+		} else if i > 0 && ops[i-1].kind == Equal {
+			// Merge this equality with the previous one.
+			ops[i-1].text += ops[i].text
+			ops = removeOps(ops, i, 1)
+		} else {
+			i++
+		}
+		ndeleted, ninserted = 0, 0
+		deleted.Reset()
+		inserted.Reset()
+	}
+	if ops[len(ops)-1].text == "" {
+		ops = ops[:len(ops)-1] // remove the sentinel
+	}
+
+	// Shift single edits surrounded by equalities over one of them.
+	changes := false
+	for i := 1; i < len(ops)-1; i++ {
+		if ops[i-1].kind != Equal || ops[i+1].kind != Equal {
+			continue
+		}
+		prev, edit, next := ops[i-1].text, ops[i].text, ops[i+1].text
+		if strings.HasSuffix(edit, prev) {
+			// Shift the edit over the previous equality.
+			ops[i].text = prev + edit[:len(edit)-len(prev)]
+			ops[i+1].text = prev + next
+			ops = removeOps(ops, i-1, 1)
+			changes = true
+		} else if strings.HasPrefix(edit, next) {
+			// Shift the edit over the next equality.
+			ops[i-1].text += next
+			ops[i].text = edit[len(next):] + next
+			ops = removeOps(ops, i+1, 1)
+			changes = true
+		}
+	}
+	// If shifts were made, the ops need merging and another sweep.
+	if changes {
+		ops = cleanupMerge(ops)
+	}
+	return ops
+}
+
+// commonPrefix returns the length in bytes of the longest common prefix
+// of a and b that ends on a rune boundary.
+func commonPrefix(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	for n > 0 && n < len(a) && !utf8.RuneStart(a[n]) {
+		n--
+	}
+	return n
+}
+
+// commonSuffix returns the length in bytes of the longest common suffix
+// of a and b that starts on a rune boundary.
+func commonSuffix(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[len(a)-1-n] == b[len(b)-1-n] {
+		n++
+	}
+	for n > 0 && !utf8.RuneStart(a[len(a)-n]) {
+		n--
+	}
+	return n
+}
+
+// commonOverlap returns the length in bytes of the longest suffix of a
+// that is a prefix of b and starts on a rune boundary.
+func commonOverlap(a, b string) int {
+	n := min(len(a), len(b))
+	a, b = a[len(a)-n:], b[:n]
+	// Look for a single rune of a's suffix in b, then extend it.
+	best := 0
+	for length := 1; length <= n; {
+		found := strings.Index(b, a[n-length:])
+		if found < 0 {
+			break
+		}
+		length += found
+		if found == 0 || a[n-length:] == b[:length] {
+			if utf8.RuneStart(a[n-length]) {
+				best = length
+			}
+			length++
+		}
+	}
+	return best
+}
@@ -0,0 +1,176 @@
+package diff
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Most of these cases are from the diff-match-patch test suite.
+
+func TestCleanupMerge(t *testing.T) {
+	tests := []struct {
+		name   string
+		ops    []op
+		expect []op
+	}{
+		{"no change", []op{{Equal, "a"}, {Delete, "b"}, {Insert, "c"}}, []op{{Equal, "a"}, {Delete, "b"}, {Insert, "c"}}},
+		{"merge equalities", []op{{Equal, "a"}, {Equal, "b"}, {Equal, "c"}}, []op{{Equal, "abc"}}},
+		{"merge deletions", []op{{Delete, "a"}, {Delete, "b"}, {Delete, "c"}}, []op{{Delete, "abc"}}},
+		{"merge interweave", []op{{Delete, "a"}, {Insert, "b"}, {Delete, "c"}, {Insert, "d"}, {Equal, "e"}, {Equal, "f"}}, []op{{Delete, "ac"}, {Insert, "bd"}, {Equal, "ef"}}},
+		{"prefix and suffix detection", []op{{Delete, "a"}, {Insert, "abc"}, {Delete, "dc"}}, []op{{Equal, "a"}, {Delete, "d"}, {Insert, "b"}, {Equal, "c"}}},
+		{"prefix and suffix with equalities", []op{{Equal, "x"}, {Delete, "a"}, {Insert, "abc"}, {Delete, "dc"}, {Equal, "y"}}, []op{{Equal, "xa"}, {Delete, "d"}, {Insert, "b"}, {Equal, "cy"}}},
+		{"slide edit left", []op{{Equal, "a"}, {Insert, "ba"}, {Equal, "c"}}, []op{{Insert, "ab"}, {Equal, "ac"}}},
+		{"slide edit right", []op{{Equal, "c"}, {Insert, "ab"}, {Equal, "a"}}, []op{{Equal, "ca"}, {Insert, "ba"}}},
+		{"slide edit left recursive", []op{{Equal, "a"}, {Delete, "b"}, {Equal, "c"}, {Delete, "ac"}, {Equal, "x"}}, []op{{Delete, "abc"}, {Equal, "acx"}}},
+		{"slide edit right recursive", []op{{Equal, "x"}, {Delete, "ca"}, {Equal, "c"}, {Delete, "b"}, {Equal, "a"}}, []op{{Equal, "xca"}, {Delete, "cba"}}},
+		{"multibyte prefix", []op{{Delete, "aé"}, {Insert, "aè"}}, []op{{Equal, "a"}, {Delete, "é"}, {Insert, "è"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expect, cleanupMerge(tt.ops))
+		})
+	}
+}
+
+func TestCleanupSemantic(t *testing.T) {
+	tests := []struct {
+		name   string
+		ops    []op
+		expect []op
+	}{
+		{"empty", nil, nil},
+		{"no elimination", []op{{Delete, "ab"}, {Insert, "cd"}, {Equal, "12"}, {Delete, "e"}}, []op{{Delete, "ab"}, {Insert, "cd"}, {Equal, "12"}, {Delete, "e"}}},
+		{"no elimination of long equality", []op{{Delete, "abc"}, {Insert, "ABC"}, {Equal, "1234"}, {Delete, "wxyz"}}, []op{{Delete, "abc"}, {Insert, "ABC"}, {Equal, "1234"}, {Delete, "wxyz"}}},
+		{"simple elimination", []op{{Delete, "a"}, {Equal, "b"}, {Delete, "c"}}, []op{{Delete, "abc"}, {Insert, "b"}}},
+		{"backpass elimination", []op{{Delete, "ab"}, {Equal, "cd"}, {Delete, "e"}, {Equal, "f"}, {Insert, "g"}}, []op{{Delete, "abcdef"}, {Insert, "cdfg"}}},
+		{"multiple eliminations", []op{{Insert, "1"}, {Equal, "A"}, {Delete, "B"}, {Insert, "2"}, {Equal, "_"}, {Insert, "1"}, {Equal, "A"}, {Delete, "B"}, {Insert, "2"}}, []op{{Delete, "AB_AB"}, {Insert, "1A2_1A2"}}},
+		{"word boundaries", []op{{Equal, "The c"}, {Delete, "ow and the c"}, {Equal, "at."}}, []op{{Equal, "The "}, {Delete, "cow and the "}, {Equal, "cat."}}},
+		{"no overlap elimination", []op{{Delete, "abcxx"}, {Insert, "xxdef"}}, []op{{Delete, "abcxx"}, {Insert, "xxdef"}}},
+		{"overlap elimination", []op{{Delete, "abcxxx"}, {Insert, "xxxdef"}}, []op{{Delete, "abc"}, {Equal, "xxx"}, {Insert, "def"}}},
+		{"reverse overlap elimination", []op{{Delete, "xxxabc"}, {Insert, "defxxx"}}, []op{{Insert, "def"}, {Equal, "xxx"}, {Delete, "abc"}}},
+		{"two overlap eliminations", []op{{Delete, "abcd1212"}, {Insert, "1212efghi"}, {Equal, "----"}, {Delete, "A3"}, {Insert, "3BC"}}, []op{{Delete, "abcd"}, {Equal, "1212"}, {Insert, "efghi"}, {Equal, "----"}, {Delete, "A"}, {Equal, "3"}, {Insert, "BC"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expect, cleanupSemantic(tt.ops))
+		})
+	}
+}
+
+func TestCleanupSemanticLossless(t *testing.T) {
+	tests := []struct {
+		name   string
+		ops    []op
+		expect []op
+	}{
+		{"blank lines", []op{{Equal, "AAA\r\n\r\nBBB"}, {Insert, "\r\nDDD\r\n\r\nBBB"}, {Equal, "\r\nEEE"}}, []op{{Equal, "AAA\r\n\r\n"}, {Insert, "BBB\r\nDDD\r\n\r\n"}, {Equal, "BBB\r\nEEE"}}},
+		{"line boundaries", []op{{Equal, "AAA\r\nBBB"}, {Insert, " DDD\r\nBBB"}, {Equal, " EEE"}}, []op{{Equal, "AAA\r\n"}, {Insert, "BBB DDD\r\n"}, {Equal, "BBB EEE"}}},
+		{"word boundaries", []op{{Equal, "The c"}, {Insert, "ow and the c"}, {Equal, "at."}}, []op{{Equal, "The "}, {Insert, "cow and the "}, {Equal, "cat."}}},
+		{"alphanumeric boundaries", []op{{Equal, "The-c"}, {Insert, "ow-and-the-c"}, {Equal, "at."}}, []op{{Equal, "The-"}, {Insert, "cow-and-the-"}, {Equal, "cat."}}},
+		{"hitting the start", []op{{Equal, "a"}, {Delete, "a"}, {Equal, "ax"}}, []op{{Delete, "a"}, {Equal, "aax"}}},
+		{"hitting the end", []op{{Equal, "xa"}, {Delete, "a"}, {Equal, "a"}}, []op{{Equal, "xaa"}, {Delete, "a"}}},
+		{"sentence boundaries", []op{{Equal, "The xxx. The "}, {Insert, "zzz. The "}, {Equal, "yyy."}}, []op{{Equal, "The xxx."}, {Insert, " The zzz."}, {Equal, " The yyy."}}},
+		{"multibyte", []op{{Equal, "ré"}, {Insert, "sumé ré"}, {Equal, "sumé"}}, []op{{Equal, "résumé"}, {Insert, " résumé"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expect, cleanupSemanticLossless(tt.ops))
+		})
+	}
+}
+
+func TestCleanupEfficiency(t *testing.T) {
+	tests := []struct {
+		name   string
+		ops    []op
+		expect []op
+	}{
+		{"empty", nil, nil},
+		{"no elimination", []op{{Delete, "ab"}, {Insert, "12"}, {Equal, "wxyz"}, {Delete, "cd"}, {Insert, "34"}}, []op{{Delete, "ab"}, {Insert, "12"}, {Equal, "wxyz"}, {Delete, "cd"}, {Insert, "34"}}},
+		{"four-edit elimination", []op{{Delete, "ab"}, {Insert, "12"}, {Equal, "xyz"}, {Delete, "cd"}, {Insert, "34"}}, []op{{Delete, "abxyzcd"}, {Insert, "12xyz34"}}},
+		{"three-edit elimination", []op{{Insert, "12"}, {Equal, "x"}, {Delete, "cd"}, {Insert, "34"}}, []op{{Delete, "xcd"}, {Insert, "12x34"}}},
+		{"backpass elimination", []op{{Delete, "ab"}, {Insert, "12"}, {Equal, "xy"}, {Insert, "34"}, {Equal, "z"}, {Delete, "cd"}, {Insert, "56"}}, []op{{Delete, "abxyzcd"}, {Insert, "12xy34z56"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expect, cleanupEfficiency(tt.ops, DefaultEditCost))
+		})
+	}
+
+	// A higher cost absorbs longer equalities.
+	ops := []op{{Delete, "ab"}, {Insert, "12"}, {Equal, "wxyz"}, {Delete, "cd"}, {Insert, "34"}}
+	require.Equal(t, []op{{Delete, "abwxyzcd"}, {Insert, "12wxyz34"}}, cleanupEfficiency(ops, 5))
+}
+
+func TestCleanupEdits(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		before, after string
+		cleanup       func(string, []Edit) ([]Edit, error)
+		expect        []Edit
+	}{
+		{"semantic", "the quick brown fox", "the slow green turtle", CleanupSemantic, []Edit{{4, 19, "slow green turtle"}}},
+		{"semantic mouse", "mouse", "sofas", CleanupSemantic, []Edit{{0, 5, "sofas"}}},
+		{"efficiency", "I love apples", "I like oranges", func(src string, edits []Edit) ([]Edit, error) {
+			return CleanupEfficiency(src, edits, DefaultEditCost)
+		}, []Edit{{3, 11, "ike orang"}}},
+		{"unchanged", "the cat sat on the mat", "the dog sat on the rug", CleanupSemantic, []Edit{{4, 7, "dog"}, {19, 22, "rug"}}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			edits := Strings(tt.before, tt.after)
+			got, err := tt.cleanup(tt.before, edits)
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, got)
+			out, err := Apply(tt.before, got)
+			require.NoError(t, err)
+			require.Equal(t, tt.after, out)
+		})
+	}
+
+	// Overlap extraction can leave empty changes, which yield no edits.
+	for _, tt := range []struct {
+		src           string
+		edits, expect []Edit
+	}{
+		{"xxx", []Edit{{0, 3, "xxxdef"}}, []Edit{{3, 3, "def"}}},
+		{"abcxxx", []Edit{{0, 6, "xxx"}}, []Edit{{0, 3, ""}}},
+	} {
+		got, err := CleanupSemantic(tt.src, tt.edits)
+		require.NoError(t, err)
+		require.Equal(t, tt.expect, got, "CleanupSemantic(%q, %v)", tt.src, tt.edits)
+	}
+
+	_, err := CleanupSemantic("abc", []Edit{{Start: 1, End: 5}})
+	require.Error(t, err)
+}
+
+func TestCleanupRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := func() string {
+		var b strings.Builder
+		for i := rng.Intn(30); i > 0; i-- {
+			b.WriteString([]string{"a", "b", "é", "ab", " ", "\n"}[rng.Intn(6)])
+		}
+		return b.String()
+	}
+	for i := 0; i < 1000; i++ {
+		before, after := random(), random()
+		edits := Strings(before, after)
+		semantic, err := CleanupSemantic(before, edits)
+		require.NoError(t, err)
+		efficient, err := CleanupEfficiency(before, edits, DefaultEditCost)
+		require.NoError(t, err)
+		for _, edits := range [][]Edit{semantic, efficient} {
+			got, err := Apply(before, edits)
+			require.NoError(t, err)
+			require.Equal(t, after, got, "before=%q after=%q edits=%v", before, after, edits)
+			for _, e := range edits {
+				require.True(t, utf8.ValidString(before[:e.Start]) && utf8.ValidString(before[e.Start:e.End]) && utf8.ValidString(e.New), "edit %v splits a rune", e)
+			}
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package diff
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/glaslos/diff/lcs"
@@ -25,10 +28,24 @@ func (e Edit) String() string {
 // Apply returns an error if any edit is out of bounds,
 // or if any pair of edits is overlapping.
 func Apply(src string, edits []Edit) (string, error) {
-	edits, size, err := validate(src, edits)
+	out, err := apply(src, edits)
 	if err != nil {
 		return "", err
 	}
+	return string(out), nil
+}
+
+// ApplyBytes is like Apply, but applies the edits to a byte slice.
+// It returns a new slice and does not modify src.
+func ApplyBytes(src []byte, edits []Edit) ([]byte, error) {
+	return apply(src, edits)
+}
+
+func apply[S string | []byte](src S, edits []Edit) ([]byte, error) {
+	edits, size, err := validate(src, edits)
+	if err != nil {
+		return nil, err
+	}
 
 	// Apply edits.
 	out := make([]byte, 0, size)
@@ -46,25 +63,185 @@ func Apply(src string, edits []Edit) (string, error) {
 		panic("wrong size")
 	}
 
-	return string(out), nil
+	return out, nil
 }
 
 // Strings computes the differences between two strings.
-// The resulting edits respect rune boundaries.
+// The resulting edits respect rune boundaries, unless either string is
+// not valid UTF-8, in which case they are computed byte by byte.
 func Strings(before, after string) []Edit {
+	return Options{}.Strings(before, after)
+}
+
+// Options controls how Options.Strings, Options.Bytes, Options.Lines
+// and Options.Words compute differences. The zero Options computes the
+// same edits as Strings, Bytes, Lines and Words.
+type Options struct {
+	// Algorithm is the method used to find the differences.
+	Algorithm lcs.Algorithm
+
+	// Limit bounds the number of differences searched for before
+	// settling for a valid but possibly non-minimal set of edits.
+	// If Limit is zero or negative, lcs.DefaultLimit is used.
+	Limit int
+
+	// Minimal removes the limit, so that the edits are always minimal,
+	// at a cost in time and memory that grows with the square of the
+	// number of differences.
+	Minimal bool
+
+	// LinearSpace finds minimal edits using memory linear in the length
+	// of the texts, however many differences they have; see
+	// lcs.Options.LinearSpace. Limit and Minimal are ignored.
+	LinearSpace bool
+
+	// IndentHeuristic makes Options.Lines slide each ambiguous block of
+	// inserted or deleted lines to where a person would put it; see
+	// IndentHeuristic.
+	IndentHeuristic bool
+
+	// Graphemes makes Options.Strings and its variants, and
+	// Options.Bytes, expand each edit to whole extended grapheme
+	// clusters; see GraphemeEdits.
+	Graphemes bool
+
+	// The following options make Options.Lines and Options.Words ignore
+	// some differences in white space, like the options of diff(1) that
+	// they are named after. Lines or words that differ only in ignored
+	// ways are matched without an edit, so applying the edits to the
+	// original text yields the new text except that such lines and words
+	// keep their original white space.
+
+	// IgnoreSpaceChange treats each run of white space as a single
+	// space, and ignores white space at the end of a line or word.
+	IgnoreSpaceChange bool
+
+	// IgnoreAllSpace ignores white space entirely.
+	IgnoreAllSpace bool
+
+	// IgnoreBlankLines makes Options.Lines ignore the insertion and
+	// deletion of lines that are empty or contain only white space.
+	IgnoreBlankLines bool
+
+	// Normalizer, if not nil, makes Options.Lines and Options.Words
+	// compare each line, with its newline, or each word, with its
+	// boundary, by its image under Normalizer; for example, FoldCase
+	// makes the comparison insensitive to case. Lines or words that are
+	// matched but still differ are replaced by edits of their own, so
+	// applying the edits to the original text yields the new text, apart
+	// from any white space ignored by the options above.
+	Normalizer func(string) string
+}
+
+// Strings computes the differences between two strings.
+// The resulting edits respect rune boundaries, unless either string is
+// not valid UTF-8, in which case they are computed byte by byte.
+func (o Options) Strings(before, after string) []Edit {
+	edits, _ := o.StringsStats(before, after)
+	return edits
+}
+
+// Bytes computes the differences between two byte slices. If both are
+// valid UTF-8, the resulting edits respect rune boundaries, as for
+// Strings; otherwise, as for binary content, they are computed byte by
+// byte.
+func Bytes(before, after []byte) []Edit {
+	return Options{}.Bytes(before, after)
+}
+
+// Bytes computes the differences between two byte slices. If both are
+// valid UTF-8, the resulting edits respect rune boundaries, as for
+// Strings; otherwise, as for binary content, they are computed byte by
+// byte.
+func (o Options) Bytes(before, after []byte) []Edit {
+	if bytes.Equal(before, after) {
+		return nil // common case
+	}
+
+	var edits []Edit
+	diff := o.lcsOptions().DiffSequences
+	if isASCII(before) && isASCII(after) || !utf8.Valid(before) || !utf8.Valid(after) {
+		edits = diffASCII(before, after, diff)
+	} else {
+		edits = diffRunes(bytes.Runes(before), bytes.Runes(after), diff)
+	}
+	if o.Graphemes {
+		edits = o.graphemes(string(before), edits)
+	}
+	return edits
+}
+
+// StringsStats is like Strings, but also returns statistics that
+// describe the computation, such as whether the edits are minimal.
+func (o Options) StringsStats(before, after string) ([]Edit, lcs.Stats) {
+	var stats lcs.Stats
+	edits := diffStrings(before, after, func(seqs lcs.Sequences) []lcs.Diff {
+		var diffs []lcs.Diff
+		diffs, stats = o.lcsOptions().DiffStats(seqs)
+		return diffs
+	})
+	return o.graphemes(before, edits), stats
+}
+
+// StringsContext is like Strings, but stops searching for differences
+// when ctx is done. It then returns edits that are valid but may not be
+// minimal, along with the context's error.
+func StringsContext(ctx context.Context, before, after string) ([]Edit, error) {
+	return Options{}.StringsContext(ctx, before, after)
+}
+
+// StringsContext is like Strings, but stops searching for differences
+// when ctx is done. It then returns edits that are valid but may not be
+// minimal, along with the context's error.
+func (o Options) StringsContext(ctx context.Context, before, after string) ([]Edit, error) {
+	var err error
+	edits := diffStrings(before, after, func(seqs lcs.Sequences) []lcs.Diff {
+		var diffs []lcs.Diff
+		diffs, err = o.lcsOptions().DiffContext(ctx, seqs)
+		return diffs
+	})
+	return o.graphemes(before, edits), err
+}
+
+// graphemes expands edits of before to whole grapheme clusters
+// if o.Graphemes is set.
+func (o Options) graphemes(before string, edits []Edit) []Edit {
+	if !o.Graphemes || len(edits) == 0 {
+		return edits
+	}
+	expanded, err := GraphemeEdits(before, edits)
+	if err != nil {
+		return edits // unreachable: the edits of before are consistent
+	}
+	return expanded
+}
+
+func (o Options) lcsOptions() lcs.Options {
+	return lcs.Options{
+		Algorithm:   o.Algorithm,
+		Limit:       o.Limit,
+		Minimal:     o.Minimal,
+		LinearSpace: o.LinearSpace,
+	}
+}
+
+// diffStrings computes the edits between two strings, using diff to
+// compare their runes or, if both are ASCII or either is not valid
+// UTF-8, their bytes.
+func diffStrings(before, after string, diff func(lcs.Sequences) []lcs.Diff) []Edit {
 	if before == after {
 		return nil // common case
 	}
 
-	if isASCII(before) && isASCII(after) {
+	if isASCII(before) && isASCII(after) || !utf8.ValidString(before) || !utf8.ValidString(after) {
 		// TODO(adonovan): opt: specialize diffASCII for strings.
-		return diffASCII([]byte(before), []byte(after))
+		return diffASCII([]byte(before), []byte(after), diff)
 	}
-	return diffRunes([]rune(before), []rune(after))
+	return diffRunes([]rune(before), []rune(after), diff)
 }
 
-func diffASCII(before, after []byte) []Edit {
-	diffs := lcs.DiffBytes(before, after)
+func diffASCII(before, after []byte, diff func(lcs.Sequences) []lcs.Diff) []Edit {
+	diffs := diff(lcs.ByteSequences(before, after))
 
 	// Convert from LCS diffs.
 	res := make([]Edit, len(diffs))
@@ -74,8 +251,8 @@ func diffASCII(before, after []byte) []Edit {
 	return res
 }
 
-func diffRunes(before, after []rune) []Edit {
-	diffs := lcs.DiffRunes(before, after)
+func diffRunes(before, after []rune, diff func(lcs.Sequences) []lcs.Diff) []Edit {
+	diffs := diff(lcs.RuneSequences(before, after))
 
 	// The diffs returned by the lcs package use indexes
 	// into whatever slice was passed in.
@@ -134,7 +311,7 @@ func (a editsSort) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 // validate checks that edits are consistent with src,
 // and returns the size of the patched output.
 // It may return a different slice.
-func validate(src string, edits []Edit) ([]Edit, int, error) {
+func validate[S string | []byte](src S, edits []Edit) ([]Edit, int, error) {
 	if !sort.IsSorted(editsSort(edits)) {
 		edits = append([]Edit(nil), edits...)
 		SortEdits(edits)
@@ -156,3 +333,80 @@ func validate(src string, edits []Edit) ([]Edit, int, error) {
 
 	return edits, size, nil
 }
+
+// LineEdits expands and merges a sequence of edits so that each
+// resulting edit replaces one or more complete lines.
+// Applying the result to src has the same effect as applying edits.
+// See Apply for preconditions.
+func LineEdits(src string, edits []Edit) ([]Edit, error) {
+	edits, _, err := validate(src, edits)
+	if err != nil {
+		return nil, err
+	}
+
+	// Do all deletions begin and end at the start of a line,
+	// and all insertions end with a newline?
+	// (This is merely a fast path.)
+	for _, edit := range edits {
+		if edit.Start >= len(src) || // insertion at EOF
+			edit.Start > 0 && src[edit.Start-1] != '\n' || // not at line start
+			edit.End > 0 && src[edit.End-1] != '\n' || // not at line start
+			edit.New != "" && edit.New[len(edit.New)-1] != '\n' { // partial insert
+			goto expand // slow path
+		}
+	}
+	return edits, nil // aligned
+
+expand:
+	if len(edits) == 0 {
+		return edits, nil // no edits (unreachable due to fast path)
+	}
+	expanded := make([]Edit, 0, len(edits)) // a guess
+	for i := 0; i < len(edits); {
+		// Find the edits[i:j] whose lines overlap.
+		j := i + 1
+		for j < len(edits) && !strings.Contains(src[edits[j-1].End:edits[j].Start], "\n") {
+			j++
+		}
+		edit := edits[i]
+		if j > i+1 {
+			// overlapping lines: combine the edits.
+			var b strings.Builder
+			b.WriteString(edit.New)
+			for _, next := range edits[i+1 : j] {
+				b.WriteString(src[edit.End:next.Start])
+				b.WriteString(next.New)
+				edit.End = next.End
+			}
+			edit.New = b.String()
+		}
+		expanded = append(expanded, expandLine(edit, src))
+		i = j
+	}
+	return expanded, nil
+}
+
+// expandLine returns edit expanded to complete whole lines.
+func expandLine(edit Edit, src string) Edit {
+	// Expand start left to start of line.
+	// (delta is the zero-based column number of start.)
+	start := edit.Start
+	if delta := start - 1 - strings.LastIndex(src[:start], "\n"); delta > 0 {
+		edit.Start -= delta
+		edit.New = src[start-delta:start] + edit.New
+	}
+
+	// Expand end right to end of line.
+	end := edit.End
+	if end > 0 && src[end-1] != '\n' ||
+		edit.New != "" && edit.New[len(edit.New)-1] != '\n' {
+		if nl := strings.IndexByte(src[end:], '\n'); nl < 0 {
+			edit.End = len(src) // extend to EOF
+		} else {
+			edit.End = end + nl + 1 // extend beyond \n
+		}
+	}
+	edit.New += src[end:edit.End]
+
+	return edit
+}
@@ -1,9 +1,15 @@
 package diff_test
 
 import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/glaslos/diff"
+	"github.com/glaslos/diff/lcs"
 )
 
 const (
@@ -285,6 +291,49 @@ type S struct {
 	},
 }
 
+func TestBytes(t *testing.T) {
+	for _, tc := range TestCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			before, after := []byte(tc.In), []byte(tc.Out)
+			edits := diff.Bytes(before, after)
+			if want := diff.Strings(tc.In, tc.Out); !reflect.DeepEqual(edits, want) {
+				t.Errorf("Bytes = %v, want Strings edits %v", edits, want)
+			}
+			got, err := diff.ApplyBytes(before, edits)
+			if err != nil {
+				t.Fatalf("ApplyBytes failed: %v", err)
+			}
+			if string(got) != tc.Out {
+				t.Errorf("ApplyBytes = %q, want %q", got, tc.Out)
+			}
+			if string(before) != tc.In {
+				t.Errorf("ApplyBytes modified src: %q", before)
+			}
+		})
+	}
+
+	for _, test := range []struct {
+		before, after string
+		want          []diff.Edit
+	}{
+		{"\xff\x00ab", "\xff\x01ab", []diff.Edit{{Start: 1, End: 2, New: "\x01"}}},
+		{"caf\xe9!", "caf\xe8!", []diff.Edit{{Start: 3, End: 4, New: "\xe8"}}},
+		{"\u00e9\xff", "\u00e8\xff", []diff.Edit{{Start: 1, End: 2, New: "\xa8"}}}, // bytes, not runes
+	} {
+		edits := diff.Bytes([]byte(test.before), []byte(test.after))
+		if !reflect.DeepEqual(edits, test.want) {
+			t.Errorf("Bytes(%q, %q) = %v, want %v", test.before, test.after, edits, test.want)
+		}
+		if got, err := diff.ApplyBytes([]byte(test.before), edits); err != nil || string(got) != test.after {
+			t.Errorf("ApplyBytes(%q) = %q, %v; want %q", test.before, got, err, test.after)
+		}
+	}
+
+	if _, err := diff.ApplyBytes([]byte("abc"), []diff.Edit{{Start: 2, End: 4}}); err == nil {
+		t.Error("ApplyBytes accepted out-of-bounds edits")
+	}
+}
+
 func TestNEdits(t *testing.T) {
 	for _, tc := range TestCases {
 		edits := diff.Strings(tc.In, tc.Out)
@@ -300,3 +349,426 @@ func TestNEdits(t *testing.T) {
 		}
 	}
 }
+
+func TestOptionsStrings(t *testing.T) {
+	rand.Seed(1)
+	for _, chars := range []string{"abcd", "abcδ"} {
+		before := randstr(chars, 400)
+		after := randstr(chars, 400)
+		size := func(edits []diff.Edit) (n int) {
+			for _, e := range edits {
+				n += e.End - e.Start + len(e.New)
+			}
+			return n
+		}
+		approximate := diff.Strings(before, after)
+		for _, opts := range []diff.Options{
+			{},
+			{Minimal: true},
+			{Limit: 20},
+			{Algorithm: lcs.Patience, Minimal: true},
+			{Algorithm: lcs.Histogram, Minimal: true},
+			{LinearSpace: true},
+		} {
+			edits := opts.Strings(before, after)
+			got, err := diff.Apply(before, edits)
+			if err != nil {
+				t.Fatalf("%+v: Apply failed: %v", opts, err)
+			}
+			if got != after {
+				t.Fatalf("%+v: Apply(Strings) = %q, want %q", opts, got, after)
+			}
+			exact := opts.Minimal || opts.LinearSpace
+			if exact && opts.Algorithm == lcs.Myers && size(edits) >= size(approximate) {
+				t.Errorf("minimal edits are no smaller than approximate ones")
+			}
+			if _, stats := opts.StringsStats(before, after); stats.Approximate == exact {
+				t.Errorf("%+v: StringsStats reports Approximate = %t", opts, stats.Approximate)
+			}
+		}
+	}
+}
+
+// randstr returns a random string of length n made of runes from s.
+func randstr(s string, n int) string {
+	src := []rune(s)
+	x := make([]rune, n)
+	for i := range x {
+		x[i] = src[rand.Intn(len(src))]
+	}
+	return string(x)
+}
+
+func TestStringsContext(t *testing.T) {
+	rand.Seed(2)
+	before := randstr("abcδ", 1000)
+	after := randstr("abcδ", 1000)
+
+	edits, err := diff.StringsContext(context.Background(), before, after)
+	if err != nil || !reflect.DeepEqual(edits, diff.Strings(before, after)) {
+		t.Errorf("StringsContext(Background) = %v, %v; want Strings edits", edits, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	edits, err = diff.Options{Minimal: true}.StringsContext(ctx, before, after)
+	if err != context.Canceled {
+		t.Errorf("StringsContext(canceled) error = %v, want %v", err, context.Canceled)
+	}
+	if got, err := diff.Apply(before, edits); err != nil || got != after {
+		t.Errorf("Apply(StringsContext(canceled)) = %q, %v; want %q", got, err, after)
+	}
+}
+
+func TestLines(t *testing.T) {
+	for _, tc := range TestCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			for _, algo := range []lcs.Algorithm{lcs.Myers, lcs.Patience, lcs.Histogram} {
+				edits := diff.Options{Algorithm: algo}.Lines(tc.In, tc.Out)
+				got, err := diff.Apply(tc.In, edits)
+				if err != nil {
+					t.Fatalf("Apply failed: %v", err)
+				}
+				if got != tc.Out {
+					t.Fatalf("algorithm %d: Apply(Lines) = %q, want %q", algo, got, tc.Out)
+				}
+				lineEdits, err := diff.LineEdits(tc.In, edits)
+				if err != nil {
+					t.Fatalf("LineEdits failed: %v", err)
+				}
+				if !reflect.DeepEqual(edits, lineEdits) {
+					t.Errorf("algorithm %d: Lines = %v, which are not whole lines", algo, edits)
+				}
+			}
+		})
+	}
+
+	edits := diff.Lines("a\nb\nc\nd\n", "a\nx\nc\nd\ne")
+	want := []diff.Edit{{Start: 2, End: 4, New: "x\n"}, {Start: 8, End: 8, New: "e"}}
+	if !reflect.DeepEqual(edits, want) {
+		t.Errorf("Lines = %v, want %v", edits, want)
+	}
+}
+
+func TestWords(t *testing.T) {
+	for _, tc := range TestCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			edits := diff.Words(tc.In, tc.Out, diff.Whitespace)
+			got, err := diff.Apply(tc.In, edits)
+			if err != nil {
+				t.Fatalf("Apply failed: %v", err)
+			}
+			if got != tc.Out {
+				t.Errorf("Apply(Words) = %q, want %q", got, tc.Out)
+			}
+		})
+	}
+
+	edits := diff.Words("the cat sat", "the dog sat", diff.Spaces)
+	want := []diff.Edit{{Start: 4, End: 8, New: "dog "}}
+	if !reflect.DeepEqual(edits, want) {
+		t.Errorf("Words = %v, want %v", edits, want)
+	}
+}
+
+func TestIgnoreSpace(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		opts          diff.Options
+		words         bool
+		before, after string
+		want          []diff.Edit
+	}{
+		{
+			name:   "space change",
+			opts:   diff.Options{IgnoreSpaceChange: true},
+			before: "a  b\nc\n",
+			after:  "a\tb \nd\n",
+			want:   []diff.Edit{{Start: 5, End: 7, New: "d\n"}},
+		},
+		{
+			name:   "space inserted",
+			opts:   diff.Options{IgnoreSpaceChange: true},
+			before: "ab\nc\n",
+			after:  "a b\nc\n",
+			want:   []diff.Edit{{Start: 0, End: 3, New: "a b\n"}},
+		},
+		{
+			name:   "all space",
+			opts:   diff.Options{IgnoreAllSpace: true},
+			before: "ab\nc\n",
+			after:  "a b\n  c\n",
+		},
+		{
+			name:   "blank lines",
+			opts:   diff.Options{IgnoreBlankLines: true},
+			before: "a\n\nb\nc\n",
+			after:  "a\nb\n \nc\nx\n",
+			want:   []diff.Edit{{Start: 7, End: 7, New: "x\n"}},
+		},
+		{
+			name:   "blank line kept",
+			opts:   diff.Options{IgnoreAllSpace: true},
+			before: "a\nb\n",
+			after:  "a\n\nb\n",
+			want:   []diff.Edit{{Start: 2, End: 2, New: "\n"}},
+		},
+		{
+			name:   "words",
+			opts:   diff.Options{IgnoreSpaceChange: true},
+			words:  true,
+			before: "foo  bar baz",
+			after:  "foo bar\tqux",
+			want:   []diff.Edit{{Start: 9, End: 12, New: "qux"}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var edits []diff.Edit
+			if test.words {
+				edits = test.opts.Words(test.before, test.after, diff.Whitespace)
+			} else {
+				edits = test.opts.Lines(test.before, test.after)
+			}
+			if !reflect.DeepEqual(edits, test.want) {
+				t.Errorf("edits = %q, want %q", edits, test.want)
+			}
+		})
+	}
+}
+
+func TestNormalizer(t *testing.T) {
+	// Compare log lines without their timestamps.
+	stamp := func(line string) string {
+		_, msg, _ := strings.Cut(line, " ")
+		return msg
+	}
+	opts := diff.Options{Normalizer: stamp}
+	before := "10:00 start\n10:00 load a\n10:01 load b\n10:02 done\n"
+	after := "10:00 start\n10:00 load a\n10:01 load c\n10:01 load b\n10:03 done\n"
+	edits := opts.Lines(before, after)
+	want := []diff.Edit{{Start: 25, End: 25, New: "10:01 load c\n"}, {Start: 38, End: 49, New: "10:03 done\n"}}
+	if !reflect.DeepEqual(edits, want) {
+		t.Errorf("Lines = %q, want %q", edits, want)
+	}
+
+	opts = diff.Options{Normalizer: diff.FoldCase}
+	edits = opts.Words("The cat sat on THE mat", "the cat sat on the red mat", diff.Spaces)
+	want = []diff.Edit{{Start: 0, End: 4, New: "the "}, {Start: 15, End: 19, New: "the red "}}
+	if !reflect.DeepEqual(edits, want) {
+		t.Errorf("Words = %q, want %q", edits, want)
+	}
+
+	rand.Seed(4)
+	for i := 0; i < 100; i++ {
+		before := randstr("aAbB \n", 100)
+		after := randstr("aAbB \n", 100)
+		for _, edits := range [][]diff.Edit{opts.Lines(before, after), opts.Words(before, after, diff.Whitespace)} {
+			if got, err := diff.Apply(before, edits); err != nil || got != after {
+				t.Fatalf("Apply = %q, %v; want %q", got, err, after)
+			}
+		}
+	}
+}
+
+func TestFoldCase(t *testing.T) {
+	for _, test := range []struct {
+		s, t string
+	}{
+		{"Hello", "hELLO"},
+		{"ΣΑΣ", "σας"},
+		{"K", "\u212a"}, // Kelvin sign
+		{"Hello", "Help"},
+		{"a", "ä"},
+	} {
+		if got, want := diff.FoldCase(test.s) == diff.FoldCase(test.t), strings.EqualFold(test.s, test.t); got != want {
+			t.Errorf("FoldCase(%q) == FoldCase(%q) is %t, want %t", test.s, test.t, got, want)
+		}
+	}
+}
+
+func TestIndentHeuristic(t *testing.T) {
+	for _, test := range []struct {
+		name, before, after string
+		want                []diff.Edit
+	}{
+		{
+			name:   "block",
+			before: "if (a) {\n  foo();\n}\nbar();\n",
+			after:  "if (a) {\n  foo();\n}\nif (b) {\n  foo();\n}\nbar();\n",
+			want:   []diff.Edit{{Start: 20, End: 20, New: "if (b) {\n  foo();\n}\n"}},
+		},
+		{
+			name:   "function",
+			before: "function a() {\n  x\n}\n\nfunction c() {\n  z\n}\n",
+			after:  "function a() {\n  x\n}\n\nfunction b() {\n  y\n}\n\nfunction c() {\n  z\n}\n",
+			want:   []diff.Edit{{Start: 22, End: 22, New: "function b() {\n  y\n}\n\n"}},
+		},
+		{
+			name:   "deletion",
+			before: "a\n\tb\n\tc\n\n\tb\n\tc\n\nd\n",
+			after:  "a\n\tb\n\tc\n\nd\n",
+			want:   []diff.Edit{{Start: 9, End: 16, New: ""}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			edits := diff.Options{IndentHeuristic: true}.Lines(test.before, test.after)
+			if !reflect.DeepEqual(edits, test.want) {
+				t.Errorf("Lines = %q, want %q", edits, test.want)
+			}
+		})
+	}
+
+	rand.Seed(3)
+	for i := 0; i < 100; i++ {
+		before := randstr("a\n\n \t{}", 200)
+		after := randstr("a\n\n \t{}", 200)
+		edits, err := diff.IndentHeuristic(before, diff.Strings(before, after))
+		if err != nil {
+			t.Fatalf("IndentHeuristic failed: %v", err)
+		}
+		if got, err := diff.Apply(before, edits); err != nil || got != after {
+			t.Fatalf("Apply(IndentHeuristic) = %q, %v; want %q", got, err, after)
+		}
+	}
+
+	if _, err := diff.IndentHeuristic("abc", []diff.Edit{{Start: 2, End: 1}}); err == nil {
+		t.Error("IndentHeuristic accepted inconsistent edits")
+	}
+}
+
+func TestToUnified(t *testing.T) {
+	for _, tc := range TestCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			unified, err := diff.ToUnified(FileA, FileB, tc.In, tc.Edits, diff.DefaultContextLines)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if unified != tc.Unified {
+				t.Errorf("ToUnified(%s):\ngot:\n%s\nwant:\n%s", tc.Name, unified, tc.Unified)
+			}
+		})
+	}
+}
+
+func TestToUnifiedContext(t *testing.T) {
+	in := "A\nB\nC\nD\nE\nF\nG\nH\nI\n"
+	edits := []diff.Edit{{Start: 2, End: 4, New: "b\n"}, {Start: 14, End: 16, New: "h\n"}}
+	for _, test := range []struct {
+		context int
+		want    string
+	}{
+		{0, UnifiedPrefix + "@@ -2 +2 @@\n-B\n+b\n@@ -8 +8 @@\n-H\n+h\n"},
+		{1, UnifiedPrefix + "@@ -1,3 +1,3 @@\n A\n-B\n+b\n C\n@@ -7,3 +7,3 @@\n G\n-H\n+h\n I\n"},
+		{3, UnifiedPrefix + "@@ -1,9 +1,9 @@\n A\n-B\n+b\n C\n D\n E\n F\n G\n-H\n+h\n I\n"},
+	} {
+		got, err := diff.ToUnified(FileA, FileB, in, edits, test.context)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("ToUnified(context=%d):\ngot:\n%s\nwant:\n%s", test.context, got, test.want)
+		}
+	}
+}
+
+func TestToUnifiedEmptyRange(t *testing.T) {
+	in := "A\nB\nC\n"
+	for _, test := range []struct {
+		edit diff.Edit
+		want string
+	}{
+		{diff.Edit{Start: 2, End: 2, New: "x\n"}, "@@ -1,0 +2 @@\n+x\n"},
+		{diff.Edit{Start: 2, End: 4, New: ""}, "@@ -2 +1,0 @@\n-B\n"},
+		{diff.Edit{Start: 0, End: 0, New: "x\n"}, "@@ -0,0 +1 @@\n+x\n"},
+	} {
+		got, err := diff.ToUnified(FileA, FileB, in, []diff.Edit{test.edit}, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := UnifiedPrefix + test.want; got != want {
+			t.Errorf("ToUnified(%v):\ngot:\n%s\nwant:\n%s", test.edit, got, want)
+		}
+	}
+}
+
+func TestToUnifiedHunks(t *testing.T) {
+	// A merged hunk that inserts more lines than it deletes shifts the
+	// start of the following hunk in the new file. The expected output
+	// is that of GNU diff -u.
+	var a, b []string
+	for i := 0; i < 30; i++ {
+		a = append(a, fmt.Sprintf("l%d\n", i))
+	}
+	b = append(b, a[:5]...)
+	b = append(b, "x1\n", "x2\n", "x3\n")
+	b = append(b, a[6:]...)
+	b[10] = "y8\n"
+	b[27] = "y25\n"
+	before, after := strings.Join(a, ""), strings.Join(b, "")
+
+	got, err := diff.ToUnified(FileA, FileB, before, diff.Lines(before, after), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := UnifiedPrefix + `@@ -3,10 +3,12 @@
+ l2
+ l3
+ l4
+-l5
++x1
++x2
++x3
+ l6
+ l7
+-l8
++y8
+ l9
+ l10
+ l11
+@@ -23,7 +25,7 @@
+ l22
+ l23
+ l24
+-l25
++y25
+ l26
+ l27
+ l28
+`
+	if got != want {
+		t.Errorf("ToUnified:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestLineEdits(t *testing.T) {
+	for _, tc := range TestCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			want := tc.LineEdits
+			if want == nil {
+				want = tc.Edits // already line-aligned
+			}
+			got, err := diff.LineEdits(tc.In, tc.Edits)
+			if err != nil {
+				t.Fatalf("LineEdits: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("in=<<%s>>\nout=<<%s>>\nraw edits=%v\nline edits=%v\nwant: %v",
+					tc.In, tc.Out, tc.Edits, got, want)
+			}
+			// Check that the result applies the same changes.
+			out, err := diff.Apply(tc.In, got)
+			if err != nil {
+				t.Fatalf("Apply: %v", err)
+			}
+			if out != tc.Out {
+				t.Errorf("Apply(LineEdits) = %q, want %q", out, tc.Out)
+			}
+		})
+	}
+}
+
+func TestLineEditsInvalid(t *testing.T) {
+	if _, err := diff.LineEdits("abc", []diff.Edit{{Start: 1, End: 5}}); err == nil {
+		t.Error("LineEdits accepted an out-of-bounds edit")
+	}
+}
@@ -0,0 +1,190 @@
+//go:build ignore
+
+// Gen_grapheme generates grapheme_tables.go, which gives the properties of
+// runes that determine extended grapheme cluster boundaries, from the
+// Unicode Character Database. It also copies GraphemeBreakTest.txt to
+// testdata for TestGraphemeBreaks.
+//
+// Usage:
+//
+//	go run gen_grapheme.go [-ucd url-or-dir]
+//
+// The UCD files are read from -ucd, which defaults to the files of the
+// Unicode version in unicodeVersion at unicode.org.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const unicodeVersion = "16.0.0"
+
+var ucd = flag.String("ucd", "https://www.unicode.org/Public/"+unicodeVersion+"/ucd", "URL or directory of the Unicode Character Database")
+
+// Names of the constants for property values in grapheme.go.
+var (
+	classNames = map[string]string{
+		"CR":                    "gcCR",
+		"LF":                    "gcLF",
+		"Control":               "gcControl",
+		"Extend":                "gcExtend",
+		"ZWJ":                   "gcZWJ",
+		"Regional_Indicator":    "gcRegionalIndicator",
+		"Prepend":               "gcPrepend",
+		"SpacingMark":           "gcSpacingMark",
+		"L":                     "gcL",
+		"V":                     "gcV",
+		"T":                     "gcT",
+		"LV":                    "gcLV",
+		"LVT":                   "gcLVT",
+		"Extended_Pictographic": "gcPictographic",
+	}
+	conjunctNames = map[string]string{
+		"Consonant": "incbConsonant",
+		"Extend":    "incbExtend",
+		"Linker":    "incbLinker",
+	}
+)
+
+const maxRune = 0x10FFFF
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen_grapheme: ")
+	flag.Parse()
+
+	class := make([]string, maxRune+1)
+	conjunct := make([]string, maxRune+1)
+	set := func(values []string, names map[string]string, lo, hi rune, value string) {
+		name, ok := names[value]
+		if !ok {
+			log.Fatalf("unknown property value %s", value)
+		}
+		for r := lo; r <= hi; r++ {
+			if values[r] != "" {
+				log.Fatalf("%U has both %s and %s", r, values[r], name)
+			}
+			values[r] = name
+		}
+	}
+	parse("auxiliary/GraphemeBreakProperty.txt", func(lo, hi rune, fields []string) {
+		set(class, classNames, lo, hi, fields[0])
+	})
+	// Extended_Pictographic runes all have Grapheme_Cluster_Break=Other,
+	// so it serves as one more class.
+	parse("emoji/emoji-data.txt", func(lo, hi rune, fields []string) {
+		if fields[0] == "Extended_Pictographic" {
+			set(class, classNames, lo, hi, fields[0])
+		}
+	})
+	parse("DerivedCoreProperties.txt", func(lo, hi rune, fields []string) {
+		if fields[0] == "InCB" {
+			set(conjunct, conjunctNames, lo, hi, fields[1])
+		}
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_grapheme.go from the Unicode Character Database %s. DO NOT EDIT.\n\n", unicodeVersion)
+	fmt.Fprintf(&buf, "package diff\n\n")
+	fmt.Fprintf(&buf, "// graphemeTable lists, in order, the ranges of runes whose\n")
+	fmt.Fprintf(&buf, "// Grapheme_Cluster_Break or Indic_Conjunct_Break property is not Other\n")
+	fmt.Fprintf(&buf, "// or None, or that are Extended_Pictographic.\n")
+	fmt.Fprintf(&buf, "var graphemeTable = [...]graphemeRange{\n")
+	for lo := rune(0); lo <= maxRune; {
+		hi := lo
+		for hi < maxRune && class[hi+1] == class[lo] && conjunct[hi+1] == conjunct[lo] {
+			hi++
+		}
+		if class[lo] != "" || conjunct[lo] != "" {
+			fmt.Fprintf(&buf, "\t{0x%04X, 0x%04X, %s, %s},\n", lo, hi, or(class[lo], "gcOther"), or(conjunct[lo], "incbNone"))
+		}
+		lo = hi + 1
+	}
+	fmt.Fprintf(&buf, "}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("grapheme_tables.go", src, 0o666); err != nil {
+		log.Fatal(err)
+	}
+
+	test, err := io.ReadAll(open("auxiliary/GraphemeBreakTest.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll("testdata", 0o777); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join("testdata", "GraphemeBreakTest.txt"), test, 0o666); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse calls f with the range of runes and the remaining fields of each
+// line of the UCD file name.
+func parse(name string, f func(lo, hi rune, fields []string)) {
+	r := open(name)
+	defer r.Close()
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		first, last, ok := strings.Cut(fields[0], "..")
+		if !ok {
+			last = first
+		}
+		lo, err1 := strconv.ParseUint(first, 16, 32)
+		hi, err2 := strconv.ParseUint(last, 16, 32)
+		if err1 != nil || err2 != nil || lo > hi || hi > maxRune || len(fields) < 2 {
+			log.Fatalf("%s: bad line %q", name, sc.Text())
+		}
+		f(rune(lo), rune(hi), fields[1:])
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatalf("%s: %v", name, err)
+	}
+}
+
+// open opens the UCD file name.
+func open(name string) io.ReadCloser {
+	if !strings.HasPrefix(*ucd, "http://") && !strings.HasPrefix(*ucd, "https://") {
+		f, err := os.Open(filepath.Join(*ucd, filepath.FromSlash(name)))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return f
+	}
+	resp, err := http.Get(*ucd + "/" + name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("%s/%s: %s", *ucd, name, resp.Status)
+	}
+	return resp.Body
+}
+
+func or(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,230 @@
+package diff
+
+import "strings"
+
+// A WordDiffMode selects one of the output formats of git diff --word-diff.
+type WordDiffMode int
+
+const (
+	// WordDiffPlain is the format of --word-diff=plain, which marks
+	// changed words as [-deleted-] and {+inserted+}.
+	WordDiffPlain WordDiffMode = iota
+	// WordDiffPorcelain is the format of --word-diff=porcelain, which
+	// writes each run of text on its own line, prefixed by ' ', '-' or
+	// '+', and writes each newline of the input as a line "~".
+	WordDiffPorcelain
+)
+
+// gitWordStyle describes how a WordDiffMode writes each kind of text.
+type gitWordStyle struct {
+	ctx, old, new [2]string // prefix and suffix
+	newline       string
+}
+
+var gitWordStyles = [...]gitWordStyle{
+	WordDiffPlain: {
+		new:     [2]string{"{+", "+}"},
+		old:     [2]string{"[-", "-]"},
+		newline: "\n",
+	},
+	WordDiffPorcelain: {
+		ctx:     [2]string{" ", "\n"},
+		new:     [2]string{"+", "\n"},
+		old:     [2]string{"-", "\n"},
+		newline: "~\n",
+	},
+}
+
+// ToGitWordDiff applies the edits to content and returns the word diff
+// that git diff --word-diff produces in the given mode, with
+// contextLines lines of context around each hunk.
+//
+// As in git, words are runs of characters other than white space,
+// changes to white space alone are not shown, the text between words is
+// taken from the edited text, and the words of each run of changed lines
+// are aligned by a port of git's xdiff. Each hunk header ends with the
+// nearest line before the hunk that starts with a letter, '_' or '$',
+// as git's default function name matching does. The changed lines are
+// those of the edits, so the output is the same as git's whenever the
+// edits change the same lines as git's own line diff.
+// The old and new labels are the names of the content and result files.
+// It returns an error if the edits are inconsistent; see Apply.
+func ToGitWordDiff(oldLabel, newLabel, content string, edits []Edit, contextLines int, mode WordDiffMode) (string, error) {
+	p, err := toPatch(oldLabel, newLabel, content, edits, contextLines)
+	if err != nil {
+		return "", err
+	}
+	if len(p.hunks) == 0 {
+		return "", nil
+	}
+
+	w := &gitWordWriter{style: &gitWordStyles[mode]}
+	w.b.WriteString("--- " + p.from + "\n")
+	w.b.WriteString("+++ " + p.to + "\n")
+	lines := splitLines(content)
+	funcName := ""
+	searched := -1 // the lines up to this one have been searched for funcName
+	for _, h := range p.hunks {
+		// As git does, keep the previous hunk's function name if no
+		// line between the hunks has one.
+		start := h.fromLine - 1
+		for l := start - 1; l > searched; l-- {
+			if name, ok := gitFuncName(lines[l]); ok {
+				funcName = name
+				break
+			}
+		}
+		searched = start - 1
+		w.b.WriteString(gitHunkHeader(h.header(), funcName) + "\n")
+		for _, l := range h.lines {
+			// git treats a line without a newline as though it had one.
+			content := l.content
+			if !strings.HasSuffix(content, "\n") {
+				content += "\n"
+			}
+			switch l.kind {
+			case Delete:
+				w.minus.WriteString(content)
+			case Insert:
+				w.plus.WriteString(content)
+			default:
+				w.flush()
+				if mode == WordDiffPorcelain {
+					w.b.WriteString(" " + content + "~\n")
+				} else {
+					w.b.WriteString(content)
+				}
+			}
+		}
+		w.flush()
+	}
+	return w.b.String(), nil
+}
+
+// gitFuncName returns the function name that git's default matching
+// takes from line, if it starts with a letter, '_' or '$': its first 80
+// bytes without trailing white space.
+func gitFuncName(line string) (string, bool) {
+	if line == "" {
+		return "", false
+	}
+	if c := line[0]; !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_' || c == '$') {
+		return "", false
+	}
+	line = line[:min(len(line), 80)]
+	return strings.TrimRight(line, " \t\n\r"), true
+}
+
+// gitHunkHeader returns header followed by funcName, cut short so that
+// the line fits in the 128 bytes that git allows for it.
+func gitHunkHeader(header, funcName string) string {
+	if funcName == "" {
+		return header
+	}
+	return header + " " + funcName[:min(len(funcName), 126-len(header))]
+}
+
+// A gitWordWriter accumulates runs of deleted and inserted lines and
+// writes the word diff between them.
+type gitWordWriter struct {
+	style       *gitWordStyle
+	b           strings.Builder
+	minus, plus strings.Builder // pending deleted and inserted text
+}
+
+// A gitWord is the byte range of a word within its text.
+type gitWord struct{ start, end int }
+
+// flush writes the word diff of the pending deleted and inserted text.
+func (w *gitWordWriter) flush() {
+	minus, plus := w.minus.String(), w.plus.String()
+	w.minus.Reset()
+	w.plus.Reset()
+	if minus == "" && plus == "" {
+		return
+	}
+	if plus == "" {
+		// special case: only removal
+		w.write(w.style.old, minus)
+		return
+	}
+
+	mwords, pwords := gitWords(minus), gitWords(plus)
+	current := 0 // offset in plus of the text not yet written
+	for _, d := range xdiffRecords(wordStrings(minus, mwords), wordStrings(plus, pwords)) {
+		mstart, mend := wordsRange(mwords, d.Start, d.End)
+		pstart, pend := wordsRange(pwords, d.ReplStart, d.ReplEnd)
+		if current != pstart {
+			w.write(w.style.ctx, plus[current:pstart])
+		}
+		if mstart != mend {
+			w.write(w.style.old, minus[mstart:mend])
+		}
+		if pstart != pend {
+			w.write(w.style.new, plus[pstart:pend])
+		}
+		current = pend
+	}
+	if current != len(plus) {
+		w.write(w.style.ctx, plus[current:])
+	}
+}
+
+// write writes text in the style given by its prefix and suffix,
+// breaking it at each newline.
+func (w *gitWordWriter) write(style [2]string, text string) {
+	for {
+		part, rest, nl := strings.Cut(text, "\n")
+		if part != "" {
+			w.b.WriteString(style[0] + part + style[1])
+		}
+		if !nl {
+			return
+		}
+		w.b.WriteString(w.style.newline)
+		text = rest
+	}
+}
+
+// gitWords returns the words of text: its runs of bytes other than
+// space, tab, newline and carriage return, which is all that git treats
+// as white space.
+func gitWords(text string) []gitWord {
+	var words []gitWord
+	start := -1
+	for i := 0; i < len(text); i++ {
+		if c := text[i]; c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+			if start >= 0 {
+				words = append(words, gitWord{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, gitWord{start, len(text)})
+	}
+	return words
+}
+
+// wordsRange returns the byte range covered by words[i:j]. If the range
+// is empty it is the end of the preceding word, or the start of the text.
+func wordsRange(words []gitWord, i, j int) (start, end int) {
+	if i < j {
+		return words[i].start, words[j-1].end
+	}
+	if i > 0 {
+		return words[i-1].end, words[i-1].end
+	}
+	return 0, 0
+}
+
+// wordStrings returns the text of each word.
+func wordStrings(text string, words []gitWord) []string {
+	strs := make([]string, len(words))
+	for i, w := range words {
+		strs[i] = text[w.start:w.end]
+	}
+	return strs
+}
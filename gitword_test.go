@@ -0,0 +1,110 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The expectations below are the output of
+// git diff --no-index --word-diff=plain|porcelain,
+// without the "diff --git" and "index" lines.
+func TestToGitWordDiff(t *testing.T) {
+	tests := []struct {
+		name             string
+		before, after    string
+		plain, porcelain string
+	}{
+		{
+			"replace",
+			"a b c\nd e f\n", "a x c\nd e f\n",
+			"@@ -1,2 +1,2 @@\na [-b-]{+x+} c\nd e f\n",
+			"@@ -1,2 +1,2 @@\n a \n-b\n+x\n  c\n~\n d e f\n~\n",
+		},
+		{
+			"delete",
+			"a b c\n", "a c\n",
+			"@@ -1 +1 @@\na[-b-] c\n",
+			"@@ -1 +1 @@\n a\n-b\n  c\n~\n",
+		},
+		{
+			"insert",
+			"a c\n", "a b c\n",
+			"@@ -1 +1 @@\na {+b+} c\n",
+			"@@ -1 +1 @@\n a \n+b\n  c\n~\n",
+		},
+		{
+			"several words",
+			"the quick brown fox\n", "the slow red fox\n",
+			"@@ -1 +1 @@\nthe [-quick brown-]{+slow red+} fox\n",
+			"@@ -1 +1 @@\n the \n-quick brown\n+slow red\n  fox\n~\n",
+		},
+		{
+			"lines",
+			"x\ny\nz\n", "x\nY Z\nz\n",
+			"@@ -1,3 +1,3 @@\nx\n[-y-]{+Y Z+}\nz\n",
+			"@@ -1,3 +1,3 @@\n x\n~\n-y\n+Y Z\n~\n z\n~\n",
+		},
+		{
+			"white space only",
+			"a  b\tc\n", "a b c\n",
+			"@@ -1 +1 @@\na b c\n",
+			"@@ -1 +1 @@\n a b c\n~\n",
+		},
+		{
+			"appended line",
+			"one\ntwo\n", "one\ntwo\nthree\n",
+			"@@ -1,2 +1,3 @@\none\ntwo\n{+three+}\n",
+			"@@ -1,2 +1,3 @@\n one\n~\n two\n~\n+three\n~\n",
+		},
+		{
+			"deleted line",
+			"one\ntwo\n", "one\n",
+			"@@ -1,2 +1 @@\none\n[-two-]\n",
+			"@@ -1,2 +1 @@\n one\n~\n-two\n~\n",
+		},
+		{
+			"no newline at end of file",
+			"a b", "a c",
+			"@@ -1 +1 @@\na [-b-]{+c+}\n",
+			"@@ -1 +1 @@\n a \n-b\n+c\n~\n",
+		},
+		{
+			"reordered words",
+			"c b\n", "b dd b c\n",
+			"@@ -1 +1 @@\n[-c-]b {+dd b c+}\n",
+			"@@ -1 +1 @@\n-c\n b \n+dd b c\n~\n",
+		},
+		{
+			"deleted last line with trailing space",
+			"a\nc ", "a\n",
+			"@@ -1,2 +1 @@\na\n[-c -]\n",
+			"@@ -1,2 +1 @@\n a\n~\n-c \n~\n",
+		},
+		{
+			"function name",
+			"func f() {\n\tone\n\ttwo\n\tthree\n\tfour\n\tfive\n}\n",
+			"func f() {\n\tone\n\ttwo\n\tthree\n\tfour\n\tsix\n}\n",
+			"@@ -3,5 +3,5 @@ func f() {\n\ttwo\n\tthree\n\tfour\n\t[-five-]{+six+}\n}\n",
+			"@@ -3,5 +3,5 @@ func f() {\n \ttwo\n~\n \tthree\n~\n \tfour\n~\n \t\n-five\n+six\n~\n }\n~\n",
+		},
+		{
+			"vertical tab within word",
+			"a\vb c\n", "a\vb d\n",
+			"@@ -1 +1 @@\na\vb [-c-]{+d+}\n",
+			"@@ -1 +1 @@\n a\vb \n-c\n+d\n~\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			edits := Strings(test.before, test.after)
+			got, err := ToGitWordDiff("a", "b", test.before, edits, DefaultContextLines, WordDiffPlain)
+			require.NoError(t, err)
+			require.Equal(t, "--- a\n+++ b\n"+test.plain, got)
+			got, err = ToGitWordDiff("a", "b", test.before, edits, DefaultContextLines, WordDiffPorcelain)
+			require.NoError(t, err)
+			require.Equal(t, "--- a\n+++ b\n"+test.porcelain, got)
+		})
+	}
+}
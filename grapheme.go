@@ -0,0 +1,189 @@
+package diff
+
+import "sort"
+
+//go:generate go run gen_grapheme.go
+
+// GraphemeEdits expands and merges a sequence of edits so that each
+// resulting edit replaces one or more complete extended grapheme
+// clusters, both in src and in the result of applying the edits, so that
+// no edit separates a letter from its combining marks or splits an
+// emoji sequence, an Indic conjunct, a flag or a CR LF pair.
+// Applying the result to src has the same effect as applying edits.
+// See Apply for preconditions.
+//
+// Cluster boundaries follow the rules of Unicode Standard Annex #29,
+// with the character properties of Unicode 16.0.0.
+func GraphemeEdits(src string, edits []Edit) ([]Edit, error) {
+	edits, _, err := validate(src, edits)
+	if err != nil {
+		return nil, err
+	}
+	if len(edits) == 0 {
+		return edits, nil
+	}
+	after, err := Apply(src, edits)
+	if err != nil {
+		return nil, err
+	}
+
+	// An offset in the unchanged text between edits is a boundary if it
+	// is one in both texts; delta is the offset in after minus that in src.
+	srcBreaks, afterBreaks := graphemeBreaks(src), graphemeBreaks(after)
+	isBreak := func(i, delta int) bool { return srcBreaks[i] && afterBreaks[i+delta] }
+
+	expanded := make([]Edit, 0, len(edits)) // a guess
+	var prev Edit
+	delta := 0
+	for i, edit := range edits {
+		// Find the first boundary after the previous edit.
+		first := edit.Start
+		if i > 0 {
+			first = prev.End
+			for first < edit.Start && !isBreak(first, delta) {
+				first++
+			}
+		}
+		if i > 0 && !isBreak(first, delta) {
+			// overlapping clusters: combine with previous edit.
+			prev.New += src[prev.End:edit.Start] + edit.New
+			prev.End = edit.End
+		} else {
+			// Expand start left to the last boundary before edit.
+			start := edit.Start
+			for !isBreak(start, delta) {
+				start--
+			}
+			if i > 0 {
+				// Expand the previous edit right to the first boundary.
+				prev.New += src[prev.End:first]
+				prev.End = first
+				expanded = append(expanded, prev)
+			}
+			prev = Edit{start, edit.End, src[start:edit.Start] + edit.New}
+		}
+		delta += len(edit.New) - (edit.End - edit.Start)
+	}
+	// Expand the final edit right to the next boundary.
+	end := prev.End
+	for !isBreak(end, delta) {
+		end++
+	}
+	prev.New += src[prev.End:end]
+	prev.End = end
+	return append(expanded, prev), nil
+}
+
+// A graphemeClass is the value of the Grapheme_Cluster_Break property
+// of a rune, with Extended_Pictographic as an additional class.
+type graphemeClass uint8
+
+const (
+	gcOther graphemeClass = iota
+	gcCR
+	gcLF
+	gcControl
+	gcExtend
+	gcZWJ
+	gcRegionalIndicator
+	gcPrepend
+	gcSpacingMark
+	gcL
+	gcV
+	gcT
+	gcLV
+	gcLVT
+	gcPictographic
+)
+
+// A conjunctClass is the value of the Indic_Conjunct_Break property of a
+// rune.
+type conjunctClass uint8
+
+const (
+	incbNone conjunctClass = iota
+	incbConsonant
+	incbExtend
+	incbLinker
+)
+
+// A graphemeRange gives the properties of the runes lo to hi.
+type graphemeRange struct {
+	lo, hi   rune
+	class    graphemeClass
+	conjunct conjunctClass
+}
+
+// graphemeBreaks reports, for each byte offset of s and for len(s),
+// whether it is an extended grapheme cluster boundary. Offsets within
+// the encoding of a rune are never boundaries.
+func graphemeBreaks(s string) []bool {
+	breaks := make([]bool, len(s)+1)
+	breaks[len(s)] = true
+	var (
+		prev      graphemeClass
+		ri        int  // number of regional indicators ending at prev
+		pict      bool // prev ends a pictograph followed by Extend*
+		pictJoin  bool // prev is a ZWJ after a pictograph and Extend*
+		consonant bool // prev ends a consonant followed by InCB Extend or Linker runes
+		linked    bool // as consonant, and at least one of the runes is a linker
+	)
+	for i, r := range s {
+		class, conjunct := graphemeProperties(r)
+		breaks[i] = i == 0 || graphemeBreak(prev, class, ri, pictJoin, linked && conjunct == incbConsonant)
+
+		if class == gcRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+		pictJoin = class == gcZWJ && pict
+		pict = class == gcPictographic || class == gcExtend && pict
+		linked = consonant && (conjunct == incbLinker || conjunct == incbExtend && linked)
+		consonant = conjunct == incbConsonant || consonant && (conjunct == incbExtend || conjunct == incbLinker)
+		prev = class
+	}
+	return breaks
+}
+
+// graphemeBreak reports whether there is a cluster boundary between
+// runes of classes prev and next, where ri and pictJoin describe the
+// runes up to prev as in graphemeBreaks and conjunctJoin reports whether
+// next is a consonant that follows a consonant and a linker.
+func graphemeBreak(prev, next graphemeClass, ri int, pictJoin, conjunctJoin bool) bool {
+	switch {
+	case prev == gcCR && next == gcLF: // GB3
+		return false
+	case prev == gcCR || prev == gcLF || prev == gcControl: // GB4
+		return true
+	case next == gcCR || next == gcLF || next == gcControl: // GB5
+		return true
+	case prev == gcL && (next == gcL || next == gcV || next == gcLV || next == gcLVT): // GB6
+		return false
+	case (prev == gcLV || prev == gcV) && (next == gcV || next == gcT): // GB7
+		return false
+	case (prev == gcLVT || prev == gcT) && next == gcT: // GB8
+		return false
+	case next == gcExtend || next == gcZWJ || next == gcSpacingMark: // GB9, GB9a
+		return false
+	case prev == gcPrepend: // GB9b
+		return false
+	case conjunctJoin: // GB9c
+		return false
+	case pictJoin && next == gcPictographic: // GB11
+		return false
+	case prev == gcRegionalIndicator && next == gcRegionalIndicator: // GB12, GB13
+		return ri%2 == 0
+	}
+	return true // GB999
+}
+
+// graphemeProperties returns the class of r and its Indic_Conjunct_Break
+// property, as given by graphemeTable.
+func graphemeProperties(r rune) (graphemeClass, conjunctClass) {
+	i := sort.Search(len(graphemeTable), func(i int) bool { return graphemeTable[i].hi >= r })
+	if i < len(graphemeTable) && graphemeTable[i].lo <= r {
+		return graphemeTable[i].class, graphemeTable[i].conjunct
+	}
+	return gcOther, incbNone
+}
@@ -0,0 +1,137 @@
+package diff
+
+import (
+	"bufio"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphemeBreaks(t *testing.T) {
+	tests := []struct {
+		name     string
+		clusters []string
+	}{
+		{"ascii", []string{"a", "b", " ", "c"}},
+		{"crlf", []string{"a", "\r\n", "\n", "\r", "b"}},
+		{"combining", []string{"e\u0301", "a\u0323\u0308", "b"}},
+		{"hangul", []string{"\uD55C", "\u1112\u1161\u11AB", "\uAC01", "\u1100\uAC00"}},
+		{"flags", []string{"\U0001F1EB\U0001F1F7", "\U0001F1E9\U0001F1EA", "\U0001F1FA"}},
+		{"zwj", []string{"\U0001F468\u200D\U0001F469\u200D\U0001F467", "a\u200D", "\U0001F44D\U0001F3FD", "\u2764\uFE0F"}},
+		{"spacing mark", []string{"\u0915\u093F", "\u0915"}},
+		{"prepend", []string{"\u0600\u0661", "a"}},
+		{"conjunct", []string{"\u0915\u094D\u0924", "\u0915\u094D", "a"}},
+		{"control", []string{"a", "\x00", "\u0301"}},
+		{"invalid", []string{"\xff", "a", "\xe2", "\x82"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := strings.Join(tt.clusters, "")
+			want := make([]bool, len(s)+1)
+			offset := 0
+			for _, c := range tt.clusters {
+				want[offset] = true
+				offset += len(c)
+			}
+			want[offset] = true
+			require.Equal(t, want, graphemeBreaks(s))
+		})
+	}
+
+	// The test cases of the Unicode Character Database, one per line,
+	// such as "÷ 0020 × 0308 ÷ 0020 ÷	# comment".
+	f, err := os.Open("testdata/GraphemeBreakTest.txt")
+	require.NoError(t, err)
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		fields := strings.Fields(strings.Split(sc.Text(), "#")[0])
+		if len(fields) == 0 {
+			continue
+		}
+		var (
+			s    strings.Builder
+			want []bool
+		)
+		for _, field := range fields {
+			switch field {
+			case "\u00F7":
+				want = append(want, true)
+			case "\u00D7":
+				want = append(want, false)
+			default:
+				r, err := strconv.ParseUint(field, 16, 32)
+				require.NoError(t, err, "line %d", line)
+				s.WriteRune(rune(r))
+				// Offsets within the encoding of a rune are not boundaries.
+				for i := len(string(rune(r))); i > 1; i-- {
+					want = append(want, false)
+				}
+			}
+		}
+		require.Equal(t, want, graphemeBreaks(s.String()), "line %d: %s", line, sc.Text())
+	}
+	require.NoError(t, sc.Err())
+}
+
+func TestGraphemeEdits(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after string
+		expect        []Edit
+	}{
+		{"ascii", "abc", "axc", []Edit{{1, 2, "x"}}},
+		{"accent", "e\u0301", "e\u0300", []Edit{{0, 3, "e\u0300"}}},
+		{"add accent", "cafe!", "cafe\u0301!", []Edit{{3, 4, "e\u0301"}}},
+		{"flag", "\U0001F1FA\U0001F1F8", "\U0001F1FA\U0001F1E6", []Edit{{0, 8, "\U0001F1FA\U0001F1E6"}}},
+		{"family", "\U0001F468\u200D\U0001F469\u200D\U0001F467", "\U0001F468\u200D\U0001F469\u200D\U0001F466", []Edit{{0, 18, "\U0001F468\u200D\U0001F469\u200D\U0001F466"}}},
+		{"crlf", "a\r\nb", "a\nb", []Edit{{1, 3, "\n"}}},
+		{"hangul", "\u1112\u1161\u11AB", "\u1112\u1161\u11AF", []Edit{{0, 9, "\u1112\u1161\u11AF"}}},
+		{"adjacent", "e\u0301e\u0301", "e\u0300e\u0300", []Edit{{0, 3, "e\u0300"}, {3, 6, "e\u0300"}}},
+		{"invalid", "a\xffb", "a\xffc", []Edit{{2, 3, "c"}}},
+		{"invalid accent", "\xffe\u0301", "\xffe\u0300\xfe", []Edit{{1, 4, "e\u0300\xfe"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expect, Options{Graphemes: true}.Strings(tt.before, tt.after))
+		})
+	}
+
+	// Edits within one cluster are combined.
+	edits, err := GraphemeEdits("a\u0301\u0302", []Edit{{1, 3, ""}, {3, 5, "\u0300"}})
+	require.NoError(t, err)
+	require.Equal(t, []Edit{{0, 5, "a\u0300"}}, edits)
+
+	_, err = GraphemeEdits("abc", []Edit{{2, 1, ""}})
+	require.Error(t, err)
+}
+
+func TestGraphemeEditsRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := func() string {
+		var b strings.Builder
+		for i := rng.Intn(20); i > 0; i-- {
+			b.WriteString([]string{"a", "e", "\u0301", "\r", "\n", "\U0001F1FA", "\U0001F1F8", "\U0001F468", "\u200D", "\u1100", "\u1161"}[rng.Intn(11)])
+		}
+		return b.String()
+	}
+	for i := 0; i < 1000; i++ {
+		before, after := random(), random()
+		edits := Options{Graphemes: true}.Strings(before, after)
+		got, err := Apply(before, edits)
+		require.NoError(t, err)
+		require.Equal(t, after, got, "before=%q after=%q edits=%v", before, after, edits)
+
+		breaks, afterBreaks := graphemeBreaks(before), graphemeBreaks(after)
+		delta := 0
+		for _, e := range edits {
+			require.True(t, breaks[e.Start] && breaks[e.End], "edit %v of %q splits a cluster", e, before)
+			require.True(t, afterBreaks[e.Start+delta] && afterBreaks[e.Start+delta+len(e.New)], "edit %v splits a cluster of %q", e, after)
+			delta += len(e.New) - (e.End - e.Start)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package diff
+
+import (
+	"html"
+	"strings"
+)
+
+// HTMLOptions controls the markup produced by RenderHTML and
+// RenderUnifiedHTML.
+type HTMLOptions struct {
+	// DeleteClass and InsertClass, if not empty, are set as the class
+	// attribute of each <del> and <ins> element.
+	DeleteClass, InsertClass string
+	// Elision replaces each elided stretch of a word diff.
+	Elision string
+}
+
+// RenderHTML renders the word diff as HTML. Each run of adjacent deleted
+// segments becomes a single <del> element, each run of inserted segments
+// a single <ins> element, and all text, including the elision, is
+// escaped. Whitespace is preserved, so the result is best shown in a
+// <pre> element or with CSS white-space: pre-wrap.
+func RenderHTML(d *WordDiff, opts HTMLOptions) string {
+	var b strings.Builder
+	segs := d.Segments
+	for i := 0; i < len(segs); {
+		kind := segs[i].Kind
+		j := i + 1
+		for j < len(segs) && segs[j].Kind == kind {
+			j++
+		}
+		switch kind {
+		case Delete:
+			writeHTMLRun(&b, "del", opts.DeleteClass, segs[i:j])
+		case Insert:
+			writeHTMLRun(&b, "ins", opts.InsertClass, segs[i:j])
+		case Equal:
+			for _, s := range segs[i:j] {
+				b.WriteString(html.EscapeString(s.Text))
+			}
+		case Elided:
+			for range segs[i:j] {
+				b.WriteString(html.EscapeString(opts.Elision))
+			}
+		}
+		i = j
+	}
+	return b.String()
+}
+
+// writeHTMLRun writes the text of segs as a single element.
+func writeHTMLRun(b *strings.Builder, tag, class string, segs []Segment) {
+	openHTML(b, tag, class)
+	for _, s := range segs {
+		b.WriteString(html.EscapeString(s.Text))
+	}
+	b.WriteString("</" + tag + ">")
+}
+
+func openHTML(b *strings.Builder, tag, class string) {
+	b.WriteString("<" + tag)
+	if class != "" {
+		b.WriteString(` class="` + html.EscapeString(class) + `"`)
+	}
+	b.WriteString(">")
+}
+
+// RenderUnifiedHTML is like ToUnified, but renders the unified diff as
+// HTML: each run of adjacent deleted lines becomes a single <del>
+// element, each run of inserted lines a single <ins> element, and all
+// text is escaped. The elision option is not used.
+func RenderUnifiedHTML(oldLabel, newLabel, content string, edits []Edit, contextLines int, opts HTMLOptions) (string, error) {
+	p, err := toPatch(oldLabel, newLabel, content, edits, contextLines)
+	if err != nil {
+		return "", err
+	}
+	if len(p.hunks) == 0 {
+		return "", nil
+	}
+
+	var b strings.Builder
+	b.WriteString(html.EscapeString("--- " + p.from + "\n"))
+	b.WriteString(html.EscapeString("+++ " + p.to + "\n"))
+	for _, h := range p.hunks {
+		b.WriteString(html.EscapeString(h.header()) + "\n")
+		for i := 0; i < len(h.lines); {
+			kind := h.lines[i].kind
+			j := i + 1
+			for j < len(h.lines) && h.lines[j].kind == kind {
+				j++
+			}
+			switch kind {
+			case Delete:
+				openHTML(&b, "del", opts.DeleteClass)
+			case Insert:
+				openHTML(&b, "ins", opts.InsertClass)
+			}
+			for _, l := range h.lines[i:j] {
+				b.WriteString(html.EscapeString(lineString(l)))
+			}
+			switch kind {
+			case Delete:
+				b.WriteString("</del>")
+			case Insert:
+				b.WriteString("</ins>")
+			}
+			i = j
+		}
+	}
+	return b.String(), nil
+}
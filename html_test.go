@@ -0,0 +1,72 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderHTML(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after string
+		opts          HTMLOptions
+		context       int
+		expect        string
+	}{
+		{
+			"escaping",
+			`if a < b && c == "x" {`,
+			`if a <= b && c == "y" {`,
+			HTMLOptions{},
+			FullContext,
+			`if a <del>&lt;</del><ins>&lt;=</ins> b &amp;&amp; c == <del>&#34;x&#34;</del><ins>&#34;y&#34;</ins> {`,
+		},
+		{
+			"grouping",
+			"the quick brown fox",
+			"the slow red fox",
+			HTMLOptions{},
+			FullContext,
+			"the <del>quick brown</del><ins>slow red</ins> fox",
+		},
+		{
+			"classes",
+			"a b c",
+			"a x c",
+			HTMLOptions{DeleteClass: "old", InsertClass: `new"`},
+			FullContext,
+			`a <del class="old">b</del><ins class="new&#34;">x</ins> c`,
+		},
+		{
+			"elision",
+			"one two three four five six",
+			"one two three four five 6",
+			HTMLOptions{Elision: "<…>"},
+			1,
+			"&lt;…&gt;five <del>six</del><ins>6</ins>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d, err := ToWordDiff(test.before, Strings(test.before, test.after), Spaces, test.context)
+			require.NoError(t, err)
+			require.Equal(t, test.expect, RenderHTML(d, test.opts))
+		})
+	}
+}
+
+func TestRenderUnifiedHTML(t *testing.T) {
+	before := "<a>\nb & c\nd\n"
+	after := "<a>\nb && c\ne\nd"
+	got, err := RenderUnifiedHTML("old", "new", before, Strings(before, after), DefaultContextLines, HTMLOptions{InsertClass: "add"})
+	require.NoError(t, err)
+	require.Equal(t, "--- old\n+++ new\n@@ -1,3 +1,4 @@\n &lt;a&gt;\n"+
+		"<del>-b &amp; c\n</del>"+`<ins class="add">+b &amp;&amp; c`+"\n</ins>"+
+		"<del>-d\n</del>"+`<ins class="add">+e`+"\n+d\n\\ No newline at end of file\n</ins>", got)
+
+	got, err = RenderUnifiedHTML("old", "new", before, nil, DefaultContextLines, HTMLOptions{})
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
@@ -0,0 +1,262 @@
+package diff
+
+import "strings"
+
+// IndentHeuristic returns edits equivalent to edits on src, expanded to
+// whole lines, in which each block of inserted or deleted lines that
+// could equally well be placed a few lines higher or lower is moved to
+// the position favoured by git's indent heuristic. The heuristic scores
+// the boundaries of each possible position by the blank lines and
+// indentation around them, so that, for example, an inserted function
+// starts with its first line rather than with the closing brace of the
+// function before it.
+// It returns an error if the edits are inconsistent; see Apply.
+func IndentHeuristic(src string, edits []Edit) ([]Edit, error) {
+	edits, err := LineEdits(src, edits)
+	if err != nil {
+		return nil, err
+	}
+	after, err := Apply(src, edits)
+	if err != nil {
+		return nil, err
+	}
+	a, b := splitLines(src), splitLines(after)
+
+	// Find the block of lines changed by each edit.
+	var blocks []lineBlock
+	delta := 0        // line number in b minus line number in a
+	line, pos := 0, 0 // line number in a of offset pos
+	for _, edit := range edits {
+		line += strings.Count(src[pos:edit.Start], "\n")
+		pos = edit.Start
+		a0 := line
+		a1 := a0 + len(splitLines(src[edit.Start:edit.End]))
+		b0, b1 := a0+delta, a0+delta+len(splitLines(edit.New))
+		delta += (b1 - b0) - (a1 - a0)
+
+		// Widening to whole lines may have pulled in unchanged lines.
+		for a0 < a1 && b0 < b1 && a[a0] == b[b0] {
+			a0, b0 = a0+1, b0+1
+		}
+		for a0 < a1 && b0 < b1 && a[a1-1] == b[b1-1] {
+			a1, b1 = a1-1, b1-1
+		}
+		blocks = append(blocks, lineBlock{a0, a1, b0, b1})
+	}
+
+	for i := range blocks {
+		// A block may slide over the unchanged lines between its
+		// neighbours, but only if it is a pure insertion or deletion.
+		lo, hi := 0, len(a)
+		if i > 0 {
+			lo = blocks[i-1].a1
+		}
+		if i+1 < len(blocks) {
+			hi = blocks[i+1].a0
+		}
+		switch bl := &blocks[i]; {
+		case bl.a0 == bl.a1 && bl.b0 < bl.b1:
+			shift := slideLines(b, bl.b0, bl.b1, lo+bl.b0-bl.a0, hi+bl.b1-bl.a1)
+			bl.a0, bl.a1, bl.b0, bl.b1 = bl.a0+shift, bl.a1+shift, bl.b0+shift, bl.b1+shift
+		case bl.b0 == bl.b1 && bl.a0 < bl.a1:
+			shift := slideLines(a, bl.a0, bl.a1, lo, hi)
+			bl.a0, bl.a1, bl.b0, bl.b1 = bl.a0+shift, bl.a1+shift, bl.b0+shift, bl.b1+shift
+		}
+	}
+
+	// Convert the blocks back to edits.
+	offsets := make([]int, len(a)+1) // byte offset of each line of a
+	for i, l := range a {
+		offsets[i+1] = offsets[i] + len(l)
+	}
+	var res []Edit
+	for _, bl := range blocks {
+		if bl.a0 == bl.a1 && bl.b0 == bl.b1 {
+			continue
+		}
+		res = append(res, Edit{offsets[bl.a0], offsets[bl.a1], strings.Join(b[bl.b0:bl.b1], "")})
+	}
+	return res, nil
+}
+
+// A lineBlock is the replacement of lines a[a0:a1] by lines b[b0:b1].
+type lineBlock struct{ a0, a1, b0, b1 int }
+
+// Constants of git's indent heuristic, from xdiff/xdiffi.c.
+const (
+	maxIndent = 200
+	maxBlanks = 20
+
+	startOfFilePenalty              = 1
+	endOfFilePenalty                = 21
+	totalBlankWeight                = -30
+	postBlankWeight                 = 6
+	relativeIndentPenalty           = -4
+	relativeIndentWithBlankPenalty  = 10
+	relativeOutdentPenalty          = 24
+	relativeOutdentWithBlankPenalty = 17
+	relativeDedentPenalty           = 23
+	relativeDedentWithBlankPenalty  = 17
+	indentWeight                    = 60
+	indentHeuristicMaxSliding       = 100
+)
+
+// slideLines returns the number of lines by which to move the block
+// lines[start:end], whose lines may be moved within lines[lo:hi] so long
+// as they stay equal to the lines they replace, to its best position
+// according to git's indent heuristic.
+func slideLines(lines []string, start, end, lo, hi int) int {
+	size := end - start
+	// Slide the block as far up, then as far down, as it will go.
+	top := start
+	for top > lo && lines[top-1] == lines[top-1+size] {
+		top--
+	}
+	bottom := start
+	for bottom+size < hi && lines[bottom] == lines[bottom+size] {
+		bottom++
+	}
+	if top == bottom {
+		return 0
+	}
+
+	// Choose among the positions near the bottom, in terms of the end
+	// of the block, preferring lower ones on a tie.
+	earliest, last := top+size, bottom+size
+	shift := max(earliest, last-size-1, last-indentHeuristicMaxSliding)
+	best := -1
+	var bestScore splitScore
+	for ; shift <= last; shift++ {
+		var score splitScore
+		score.add(measureSplit(lines, shift))
+		score.add(measureSplit(lines, shift-size))
+		if best == -1 || score.cmp(bestScore) <= 0 {
+			best, bestScore = shift, score
+		}
+	}
+	return best - end
+}
+
+// A splitMeasurement describes the surroundings of a split between two
+// lines, which is where a block of changed lines starts or ends.
+type splitMeasurement struct {
+	endOfFile  bool // the split is at the end of the file
+	indent     int  // indent of the line after the split, or -1 if blank
+	preBlank   int  // number of blank lines before the split
+	preIndent  int  // indent of the nearest non-blank line before the split, or -1
+	postBlank  int  // number of blank lines after the line after the split
+	postIndent int  // indent of the nearest non-blank line after the line after the split, or -1
+}
+
+// measureSplit measures the split before lines[split].
+func measureSplit(lines []string, split int) splitMeasurement {
+	m := splitMeasurement{indent: -1, preIndent: -1, postIndent: -1}
+	if split >= len(lines) {
+		m.endOfFile = true
+	} else {
+		m.indent = lineIndent(lines[split])
+	}
+	for i := split - 1; i >= 0; i-- {
+		if m.preIndent = lineIndent(lines[i]); m.preIndent != -1 {
+			break
+		}
+		if m.preBlank++; m.preBlank == maxBlanks {
+			m.preIndent = 0
+			break
+		}
+	}
+	for i := split + 1; i < len(lines); i++ {
+		if m.postIndent = lineIndent(lines[i]); m.postIndent != -1 {
+			break
+		}
+		if m.postBlank++; m.postBlank == maxBlanks {
+			m.postIndent = 0
+			break
+		}
+	}
+	return m
+}
+
+// lineIndent returns the width of the indentation of line, with tabs
+// to multiples of 8, or -1 if the line is blank.
+func lineIndent(line string) int {
+	indent := 0
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; c {
+		case ' ':
+			indent++
+		case '\t':
+			indent += 8 - indent%8
+		case '\n', '\v', '\f', '\r':
+			// Other white space does not count.
+		default:
+			return indent
+		}
+		if indent >= maxIndent {
+			return maxIndent
+		}
+	}
+	return -1
+}
+
+// A splitScore is the badness of the splits at either end of a block.
+type splitScore struct {
+	effectiveIndent int
+	penalty         int
+}
+
+// add adds the score of a split with measurement m.
+func (s *splitScore) add(m splitMeasurement) {
+	if m.preIndent == -1 && m.preBlank == 0 {
+		s.penalty += startOfFilePenalty
+	}
+	if m.endOfFile {
+		s.penalty += endOfFilePenalty
+	}
+
+	postBlank := 0
+	if m.indent == -1 {
+		postBlank = 1 + m.postBlank
+	}
+	totalBlank := m.preBlank + postBlank
+	s.penalty += totalBlankWeight * totalBlank
+	s.penalty += postBlankWeight * postBlank
+
+	indent := m.indent
+	if indent == -1 {
+		indent = m.postIndent
+	}
+	anyBlanks := totalBlank != 0
+	s.effectiveIndent += indent
+
+	switch {
+	case indent == -1, m.preIndent == -1, indent == m.preIndent:
+		// No adjustment needed.
+	case indent > m.preIndent:
+		s.penalty += pick(anyBlanks, relativeIndentWithBlankPenalty, relativeIndentPenalty)
+	case m.postIndent != -1 && m.postIndent > indent:
+		s.penalty += pick(anyBlanks, relativeOutdentWithBlankPenalty, relativeOutdentPenalty)
+	default:
+		s.penalty += pick(anyBlanks, relativeDedentWithBlankPenalty, relativeDedentPenalty)
+	}
+}
+
+// cmp returns a negative number if s is better than t, a positive
+// number if it is worse, and zero if they are equally good.
+func (s splitScore) cmp(t splitScore) int {
+	cmpIndents := 0
+	switch {
+	case s.effectiveIndent > t.effectiveIndent:
+		cmpIndents = 1
+	case s.effectiveIndent < t.effectiveIndent:
+		cmpIndents = -1
+	}
+	return indentWeight*cmpIndents + (s.penalty - t.penalty)
+}
+
+func pick(cond bool, x, y int) int {
+	if cond {
+		return x
+	}
+	return y
+}
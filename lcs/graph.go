@@ -4,13 +4,27 @@ import "fmt"
 
 // editGraph carries the information for computing the lcs of two sequences.
 type editGraph struct {
-	seqs   sequences
+	seqs   Sequences
 	vf, vb label // forward and backward labels
 
 	limit int // maximal value of D
 	// the bounding rectangle of the current edit graph
 	lx, ly, ux, uy int
 	delta          int // common subexpression: (ux-lx)-(uy-ly)
+
+	done        <-chan struct{} // closed to stop the search early, or nil
+	interrupted bool            // whether the search was stopped by done
+	stats       Stats           // statistics of the twosided search
+}
+
+// stopped reports whether the search should stop early.
+func (e *editGraph) stopped() bool {
+	select {
+	case <-e.done:
+		return true
+	default:
+		return false
+	}
 }
 
 func (e *editGraph) setForward(d, k, relx int) {
@@ -24,7 +38,7 @@ func (e *editGraph) lookForward(k, relx int) int {
 	rely := relx - k
 	x, y := relx+e.lx, rely+e.ly
 	if x < e.ux && y < e.uy {
-		x += e.seqs.commonPrefixLen(x, e.ux, y, e.uy)
+		x += e.seqs.CommonPrefixLen(x, e.ux, y, e.uy)
 	}
 	return x
 }
@@ -34,7 +48,7 @@ func (e *editGraph) lookBackward(k, relx int) int {
 	rely := relx - (k + e.delta) // forward k = k + e.delta
 	x, y := relx+e.lx, rely+e.ly
 	if x > 0 && y > 0 {
-		x -= e.seqs.commonSuffixLen(0, x, 0, y)
+		x -= e.seqs.CommonSuffixLen(0, x, 0, y)
 	}
 	return x
 }
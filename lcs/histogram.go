@@ -0,0 +1,90 @@
+package lcs
+
+// Histogram diff, as in git: the alignment is anchored on the longest
+// common region whose rarest element occurs fewest times in A, and the
+// parts before and after it are diffed recursively. Elements that occur
+// too often in A to be worth anchoring on, such as blank lines or closing
+// braces, are only matched as part of a region anchored elsewhere, and a
+// part in which every common element is too frequent is diffed by Myers'
+// algorithm.
+
+// maxChainLength is the number of occurrences in A beyond which an
+// element is too frequent to anchor a region. It is git's value.
+const maxChainLength = 64
+
+// A region is a run of n equal elements, A[x:x+n] and B[y:y+n].
+type region struct{ x, y, n int }
+
+// histogram appends to diffs the differences between the sequences of
+// keys a and b, which start at offsets aoff and boff in the complete
+// sequences.
+func (s *search) histogram(diffs []Diff, a, b []int, aoff, boff int) []Diff {
+	a, b, aoff, boff = trimCommon(a, b, aoff, boff)
+	if len(a) == 0 || len(b) == 0 {
+		return appendChange(diffs, a, b, aoff, boff)
+	}
+	if s.stopped() {
+		// Out of time: replace all that is left.
+		return appendChange(diffs, a, b, aoff, boff)
+	}
+
+	r, common := rarestRegion(a, b)
+	if r.n == 0 {
+		if common {
+			return s.myersKeys(diffs, a, b, aoff, boff)
+		}
+		return appendChange(diffs, a, b, aoff, boff)
+	}
+	diffs = s.histogram(diffs, a[:r.x], b[:r.y], aoff, boff)
+	x, y := r.x+r.n, r.y+r.n
+	return s.histogram(diffs, a[x:], b[y:], aoff+x, boff+y)
+}
+
+// rarestRegion returns a common region of a and b chosen as by try_lcs
+// in git's xdiff/xhistogram.c. Scanning b, each element that occurs in a
+// no more often than the rarest element of the best region so far is
+// extended into a common region, which becomes the best one if it is
+// longer than it or if its rarest element occurs fewer times in a.
+// Elements that occur more than maxChainLength times in a are never
+// tried, so the region is empty if no other element is common to a and
+// b; common reports whether they have any element in common.
+func rarestRegion(a, b []int) (r region, common bool) {
+	occurs := make(map[int][]int) // indexes in a of each element
+	for x, k := range a {
+		occurs[k] = append(occurs[k], x)
+	}
+	count := func(x int) int { return len(occurs[a[x]]) }
+
+	best := maxChainLength + 1 // count of the rarest element of r
+	for y := 0; y < len(b); {
+		next := y + 1
+		xs := occurs[b[y]]
+		if len(xs) > 0 {
+			common = true
+		}
+		if len(xs) > best {
+			xs = nil
+		}
+		for _, x := range xs {
+			// Extend the match of a[x] and b[y] in both directions.
+			rarest := len(xs)
+			x0, y0 := x, y
+			for x0 > 0 && y0 > 0 && a[x0-1] == b[y0-1] {
+				x0, y0 = x0-1, y0-1
+				rarest = min(rarest, count(x0))
+			}
+			x1, y1 := x+1, y+1
+			for x1 < len(a) && y1 < len(b) && a[x1] == b[y1] {
+				rarest = min(rarest, count(x1))
+				x1, y1 = x1+1, y1+1
+			}
+			next = max(next, y1)
+			if r.n < x1-x0 || rarest < best {
+				r = region{x0, y0, x1 - x0}
+				best = rarest
+			}
+		}
+		y = next
+	}
+	return r, common
+}
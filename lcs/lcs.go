@@ -1,6 +1,7 @@
 package lcs
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -135,27 +136,221 @@ type Diff struct {
 
 // DiffBytes returns the differences between two byte sequences.
 // It does not respect rune boundaries.
-func DiffBytes(a, b []byte) []Diff { return diff(bytesSeqs{a, b}) }
+func DiffBytes(a, b []byte) []Diff { return Options{}.DiffBytes(a, b) }
 
 // DiffRunes returns the differences between two rune sequences.
-func DiffRunes(a, b []rune) []Diff { return diff(runesSeqs{a, b}) }
+func DiffRunes(a, b []rune) []Diff { return Options{}.DiffRunes(a, b) }
 
-func diff(seqs sequences) []Diff {
-	// A limit on how deeply the LCS algorithm should search. The value is just a guess.
-	const maxDiffs = 100
-	diff, _ := compute(seqs, twosided, maxDiffs/2)
-	return diff
+// DiffSlices returns the differences between two slices of comparable elements.
+func DiffSlices[T comparable](a, b []T) []Diff { return Options{}.diff(slicesSeqs[T]{a, b}) }
+
+// DiffFunc returns the differences between two slices,
+// using eq to decide whether two elements are equal.
+func DiffFunc[T any](a, b []T, eq func(T, T) bool) []Diff {
+	return Options{}.diff(funcSeqs[T]{a, b, eq})
+}
+
+// DiffSequences returns the differences between the two sequences of seqs.
+func DiffSequences(seqs Sequences) []Diff { return Options{}.diff(seqs) }
+
+// DiffContext is like DiffSequences, but stops searching when ctx is
+// done; see Options.DiffContext.
+func DiffContext(ctx context.Context, seqs Sequences) ([]Diff, error) {
+	return Options{}.DiffContext(ctx, seqs)
+}
+
+// An Algorithm is a method of finding the differences between two sequences.
+type Algorithm int
+
+const (
+	// Myers is Myers' O(ND) algorithm, searching from both ends at once.
+	Myers Algorithm = iota
+	// Patience is Bram Cohen's patience diff, which first aligns the
+	// elements that occur exactly once in each sequence. In source code
+	// this keeps changes from being aligned on braces and blank lines.
+	// It requires KeyedSequences, and uses Myers for other Sequences.
+	Patience
+	// Histogram is git's histogram diff, which aligns the longest
+	// common runs of the least frequent elements first. It gives better
+	// results than Myers on text with many repeated low-information
+	// lines, such as closing braces. Like Patience, it requires
+	// KeyedSequences, and uses Myers for other Sequences.
+	Histogram
+)
+
+// DefaultLimit is the number of differences beyond which, unless
+// Options.Minimal is set, the search for a minimal diff gives up and an
+// approximate result is returned. The value is just a guess.
+const DefaultLimit = 100
+
+// Options controls how differences are computed.
+// The zero Options uses Myers' algorithm with DefaultLimit.
+type Options struct {
+	// Algorithm is the method used to find the differences.
+	Algorithm Algorithm
+
+	// Limit bounds the number of differences that Myers' algorithm,
+	// also used within Patience and Histogram, searches for. Sequences
+	// with more differences get a valid but possibly non-minimal diff.
+	// If Limit is zero or negative, DefaultLimit is used.
+	Limit int
+
+	// Minimal removes the limit, so that Myers' algorithm always finds a
+	// minimal diff, at a cost in time and memory that grows with the
+	// square of the number of differences.
+	Minimal bool
+
+	// LinearSpace makes Myers' algorithm divide the search at the middle
+	// of an optimal path, so that it needs memory linear in the length
+	// of the sequences rather than quadratic in the number of
+	// differences. Its diffs are always minimal; Limit and Minimal are
+	// ignored.
+	LinearSpace bool
+}
+
+// DiffBytes returns the differences between two byte sequences.
+// It does not respect rune boundaries.
+func (o Options) DiffBytes(a, b []byte) []Diff { return o.diff(bytesSeqs{a, b}) }
+
+// DiffRunes returns the differences between two rune sequences.
+func (o Options) DiffRunes(a, b []rune) []Diff { return o.diff(runesSeqs{a, b}) }
+
+// DiffSequences returns the differences between the two sequences of seqs.
+func (o Options) DiffSequences(seqs Sequences) []Diff { return o.diff(seqs) }
+
+// DiffStats is like DiffSequences, but also returns statistics that
+// describe the computation, such as whether the diff is approximate.
+func (o Options) DiffStats(seqs Sequences) ([]Diff, Stats) {
+	s := &search{limit: o.limit(), linear: o.LinearSpace}
+	return o.run(s, seqs), s.stats
+}
+
+// DiffContext is like DiffSequences, but stops searching when ctx is
+// done. It then returns the best diff found so far, which is valid but
+// may not be minimal, along with the context's error.
+func (o Options) DiffContext(ctx context.Context, seqs Sequences) ([]Diff, error) {
+	s := &search{limit: o.limit(), linear: o.LinearSpace, done: ctx.Done()}
+	diffs := o.run(s, seqs)
+	if s.interrupted {
+		return diffs, ctx.Err()
+	}
+	return diffs, nil
+}
+
+func (o Options) diff(seqs Sequences) []Diff {
+	diffs, _ := o.DiffStats(seqs)
+	return diffs
+}
+
+// run returns the differences between the two sequences of seqs,
+// found by the algorithm of the options.
+func (o Options) run(s *search, seqs Sequences) []Diff {
+	if keyed, ok := seqs.(KeyedSequences); ok {
+		switch o.Algorithm {
+		case Patience:
+			a, b := keyed.Keys()
+			return s.patience(nil, a, b, 0, 0)
+		case Histogram:
+			a, b := keyed.Keys()
+			return s.histogram(nil, a, b, 0, 0)
+		}
+	}
+	return s.myers(seqs)
+}
+
+// limit returns the limit on D to pass to compute. The two-sided search
+// finds up to 2D differences, so it is half the limit of the options.
+func (o Options) limit() int {
+	switch {
+	case o.Minimal:
+		return 0 // no limit
+	case o.Limit <= 0:
+		return DefaultLimit / 2
+	}
+	return max(o.Limit/2, 1)
+}
+
+// Stats describes the computation of a diff.
+type Stats struct {
+	// Approximate reports whether Myers' algorithm reached the limit,
+	// or the search was stopped early, so that the diff may not be
+	// minimal.
+	Approximate bool
+
+	// D is the number of differences searched by Myers' algorithm,
+	// summed over each part of the sequences it was used on. If the
+	// diff is exact, that is the number of elements deleted or inserted
+	// in those parts. A search that reached the limit counts the limit.
+	D int
+
+	// Discarded is the number of common runs found by searches that
+	// reached the limit which were dropped because they conflicted.
+	Discarded int
+}
+
+// add accumulates the statistics of a part of a diff.
+func (s *Stats) add(part Stats) {
+	s.Approximate = s.Approximate || part.Approximate
+	s.D += part.D
+	s.Discarded += part.Discarded
+}
+
+// A search is the state of a diff computation, which may use Myers'
+// algorithm on several parts of the sequences.
+type search struct {
+	limit       int             // limit on D for compute
+	linear      bool            // use linear-space Myers
+	done        <-chan struct{} // closed to stop the search, or nil
+	interrupted bool            // whether done was closed during the search
+	stats       Stats
+}
+
+// stopped reports whether done has been closed, recording that the
+// search was interrupted and so may not be minimal.
+func (s *search) stopped() bool {
+	select {
+	case <-s.done:
+		s.interrupted = true
+		s.stats.Approximate = true
+		return true
+	default:
+		return false
+	}
+}
+
+// myers returns the differences between the two sequences of seqs
+// found by the two-sided or, if linear is set, the linear-space Myers
+// algorithm, and adds to its statistics.
+func (s *search) myers(seqs Sequences) []Diff {
+	if s.linear {
+		return s.myersLinear(seqs)
+	}
+	e := newEditGraph(seqs, s.limit)
+	e.done = s.done
+	lcs := twosided(e)
+	s.stats.add(e.stats)
+	s.interrupted = s.interrupted || e.interrupted
+	return lcs.toDiffs(e.ux, e.uy)
 }
 
 // compute computes the list of differences between two sequences,
 // along with the LCS. It is exercised directly by tests.
 // The algorithm is one of {forward, backward, twosided}.
-func compute(seqs sequences, algo func(*editGraph) lcs, limit int) ([]Diff, lcs) {
+func compute(seqs Sequences, algo func(*editGraph) lcs, limit int) ([]Diff, lcs) {
+	e := newEditGraph(seqs, limit)
+	lcs := algo(e)
+	diffs := lcs.toDiffs(e.ux, e.uy)
+	return diffs, lcs
+}
+
+// newEditGraph returns the edit graph of seqs for a search of at most
+// limit steps in each direction, or an unlimited one if limit <= 0.
+func newEditGraph(seqs Sequences, limit int) *editGraph {
 	if limit <= 0 {
 		limit = 1 << 25 // effectively infinity
 	}
-	alen, blen := seqs.lengths()
-	g := &editGraph{
+	alen, blen := seqs.Lengths()
+	return &editGraph{
 		seqs:  seqs,
 		vf:    newtriang(limit),
 		vb:    newtriang(limit),
@@ -164,9 +359,6 @@ func compute(seqs sequences, algo func(*editGraph) lcs, limit int) ([]Diff, lcs)
 		uy:    blen,
 		delta: alen - blen,
 	}
-	lcs := algo(g)
-	diffs := lcs.toDiffs(alen, blen)
-	return diffs, lcs
 }
 
 func twosided(e *editGraph) lcs {
@@ -179,8 +371,15 @@ func twosided(e *editGraph) lcs {
 
 	// from D to D+1
 	for D := 0; D < e.limit; D++ {
+		if e.stopped() {
+			// Out of time: treat D as the limit.
+			e.interrupted = true
+			e.limit = D
+			break
+		}
 		// just finished a backwards pass, so check
 		if got, ok := e.twoDone(D, D); ok {
+			e.stats.D = 2 * D
 			return e.twolcs(D, D, got)
 		}
 		// do a forwards pass (D to D+1)
@@ -198,6 +397,7 @@ func twosided(e *editGraph) lcs {
 		}
 		// just did a forward pass, so check
 		if got, ok := e.twoDone(D+1, D); ok {
+			e.stats.D = 2*D + 1
 			return e.twolcs(D+1, D, got)
 		}
 		// do a backward pass, D to D+1
@@ -247,6 +447,7 @@ func twosided(e *editGraph) lcs {
 	lcs = append(lcs, e.backwardlcs(e.limit, kmax)...)
 	// These may overlap (e.forwardlcs and e.backwardlcs return sorted lcs)
 	ans := lcs.fix()
+	e.stats = Stats{Approximate: true, D: 2 * e.limit, Discarded: len(lcs) - len(ans)}
 	return ans
 }
 
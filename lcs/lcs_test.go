@@ -5,10 +5,12 @@
 package lcs
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -151,6 +153,349 @@ func TestDiffAPI(t *testing.T) {
 	}
 }
 
+func TestDiffSlices(t *testing.T) {
+	for _, test := range []struct {
+		a, b []string
+		want string
+	}{
+		{[]string{"a", "b", "c"}, []string{"a", "x", "c"}, "[{1 2 1 2}]"},
+		{[]string{"a", "b"}, []string{"a", "b", "c", "d"}, "[{2 2 2 4}]"},
+		{nil, []string{"a"}, "[{0 0 0 1}]"},
+		{[]string{"a"}, []string{"a"}, "[]"},
+	} {
+		if got := fmt.Sprint(DiffSlices(test.a, test.b)); got != test.want {
+			t.Errorf("DiffSlices(%q, %q) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+
+	// DiffSlices and DiffFunc agree with DiffRunes.
+	rand.Seed(2)
+	for i := 0; i < 100; i++ {
+		a := []rune(randstr("abω", 20))
+		b := []rune(randstr("abωc", 20))
+		want := fmt.Sprint(DiffRunes(a, b))
+		if got := fmt.Sprint(DiffSlices(a, b)); got != want {
+			t.Errorf("DiffSlices(%q, %q) = %v, want %v", string(a), string(b), got, want)
+		}
+		eq := func(x, y rune) bool { return x == y }
+		if got := fmt.Sprint(DiffFunc(a, b, eq)); got != want {
+			t.Errorf("DiffFunc(%q, %q) = %v, want %v", string(a), string(b), got, want)
+		}
+	}
+}
+
+func TestDiffFunc(t *testing.T) {
+	type record struct {
+		id   int
+		name string
+	}
+	a := []record{{1, "ann"}, {2, "bob"}, {3, "cid"}}
+	b := []record{{1, "Ann"}, {3, "cid"}, {4, "dee"}}
+	sameID := func(x, y record) bool { return x.id == y.id }
+	if got, want := fmt.Sprint(DiffFunc(a, b, sameID)), "[{1 2 1 1} {3 3 2 3}]"; got != want {
+		t.Errorf("DiffFunc = %v, want %v", got, want)
+	}
+}
+
+// genSeqs is a user-defined Sequences whose elements are computed on
+// demand rather than stored.
+type genSeqs struct {
+	alen, blen int
+	a, b       func(int) int
+}
+
+func (s genSeqs) Lengths() (int, int) { return s.alen, s.blen }
+func (s genSeqs) CommonPrefixLen(ai, aj, bi, bj int) int {
+	n := 0
+	for ai+n < aj && bi+n < bj && s.a(ai+n) == s.b(bi+n) {
+		n++
+	}
+	return n
+}
+func (s genSeqs) CommonSuffixLen(ai, aj, bi, bj int) int {
+	n := 0
+	for aj-n > ai && bj-n > bi && s.a(aj-n-1) == s.b(bj-n-1) {
+		n++
+	}
+	return n
+}
+
+func TestDiffSequences(t *testing.T) {
+	seqs := genSeqs{
+		alen: 50,
+		blen: 60,
+		a:    func(i int) int { return i % 7 },
+		b:    func(j int) int { return j * 3 % 11 },
+	}
+	var a, b []int
+	for i := 0; i < seqs.alen; i++ {
+		a = append(a, seqs.a(i))
+	}
+	for j := 0; j < seqs.blen; j++ {
+		b = append(b, seqs.b(j))
+	}
+	got := fmt.Sprint(DiffSequences(seqs))
+	if want := fmt.Sprint(DiffSlices(a, b)); got != want {
+		t.Errorf("DiffSequences = %v, want %v", got, want)
+	}
+}
+
+func TestAlgorithms(t *testing.T) {
+	a := []string{
+		"void func1() {",
+		"    x += 1",
+		"}",
+		"",
+		"void func2() {",
+		"    x += 2",
+		"}",
+	}
+	b := []string{
+		"void func1() {",
+		"    x += 1",
+		"}",
+		"",
+		"void functhreehalves() {",
+		"    x += 1.5",
+		"}",
+		"",
+		"void func2() {",
+		"    x += 2",
+		"}",
+	}
+	// Myers aligns the new function on the closing brace of the first.
+	for _, test := range []struct {
+		algo Algorithm
+		want string
+	}{
+		{Myers, "[{2 2 2 6}]"},
+		{Patience, "[{4 4 4 8}]"},
+		{Histogram, "[{4 4 4 8}]"},
+	} {
+		opts := Options{Algorithm: test.algo}
+		if got := fmt.Sprint(opts.DiffSequences(slicesSeqs[string]{a, b})); got != test.want {
+			t.Errorf("algorithm %d: diff = %v, want %v", test.algo, got, test.want)
+		}
+
+		// Unkeyed sequences fall back to Myers.
+		eq := func(x, y string) bool { return x == y }
+		got := fmt.Sprint(opts.DiffSequences(funcSeqs[string]{a, b, eq}))
+		if want := fmt.Sprint(DiffSlices(a, b)); got != want {
+			t.Errorf("algorithm %d: diff of unkeyed sequences = %v, want %v", test.algo, got, want)
+		}
+	}
+}
+
+func TestHistogramFrequent(t *testing.T) {
+	// Elements too frequent to anchor on fall back to Myers.
+	a := []rune(strings.Repeat("a", maxChainLength+6) + "b")
+	b := []rune("c" + strings.Repeat("a", maxChainLength+6))
+	got := fmt.Sprint(Options{Algorithm: Histogram}.DiffRunes(a, b))
+	if want := fmt.Sprint(DiffRunes(a, b)); got != want {
+		t.Errorf("Histogram diff = %v, want %v", got, want)
+	}
+
+	// The rare "z" anchors the alignment, not the longer run of "a".
+	a = []rune("xaaaayz")
+	b = []rune("aaaazyx")
+	if got, want := fmt.Sprint(Options{Algorithm: Histogram}.DiffRunes(a, b)), "[{0 1 0 0} {5 6 4 4} {7 7 5 7}]"; got != want {
+		t.Errorf("Histogram diff = %v, want %v", got, want)
+	}
+}
+
+func TestAlgorithmsRand(t *testing.T) {
+	rand.Seed(3)
+	for _, algo := range []Algorithm{Myers, Patience, Histogram} {
+		for _, linear := range []bool{false, true} {
+			opts := Options{Algorithm: algo, LinearSpace: linear}
+			for i := 0; i < 1000; i++ {
+				a := []rune(randstr("abcdefω", rand.Intn(40)))
+				b := []rune(randstr("abcdefgω", rand.Intn(40)))
+				checkDiffs(t, a, b, opts.DiffRunes(a, b))
+			}
+		}
+	}
+}
+
+// checkDiffs reports an error unless diffs are ordered, do not touch,
+// and transform a into b.
+func checkDiffs[T comparable](t *testing.T, a, b []T, diffs []Diff) {
+	t.Helper()
+	var got []T
+	pa, pb := 0, 0
+	for _, d := range diffs {
+		if d.Start < pa || d.ReplStart < pb || d.Start > d.End || d.ReplStart > d.ReplEnd ||
+			(pa > 0 || pb > 0) && d.Start == pa && d.ReplStart == pb {
+			t.Fatalf("diff(%v, %v): bad diffs %v", a, b, diffs)
+		}
+		if d.Start-pa != d.ReplStart-pb {
+			t.Fatalf("diff(%v, %v): unequal gap before %v in %v", a, b, d, diffs)
+		}
+		got = append(got, a[pa:d.Start]...)
+		got = append(got, b[d.ReplStart:d.ReplEnd]...)
+		pa, pb = d.End, d.ReplEnd
+	}
+	got = append(got, a[pa:]...)
+	if fmt.Sprint(got) != fmt.Sprint(b) {
+		t.Fatalf("diff(%v, %v) = %v, which yields %v", a, b, diffs, got)
+	}
+}
+
+func TestOptionsLimit(t *testing.T) {
+	// common returns the number of elements of a left unchanged by diffs.
+	common := func(a []rune, diffs []Diff) int {
+		n := len(a)
+		for _, d := range diffs {
+			n -= d.End - d.Start
+		}
+		return n
+	}
+
+	rand.Seed(4)
+	approximate := 0
+	for i := 0; i < 20; i++ {
+		a := []rune(randstr("abcω", 300))
+		b := []rune(randstr("abcω", 300))
+		_, exact := compute(runesSeqs{a, b}, forward, 0)
+
+		for _, opts := range []Options{{Minimal: true}, {Limit: 1000}, {LinearSpace: true}} {
+			diffs := opts.DiffRunes(a, b)
+			checkDiffs(t, a, b, diffs)
+			if got, want := common(a, diffs), lcslen(exact); got != want {
+				t.Errorf("%+v: diff keeps %d elements, want %d", opts, got, want)
+			}
+		}
+		diffs := DiffRunes(a, b)
+		checkDiffs(t, a, b, diffs)
+		if common(a, diffs) < lcslen(exact) {
+			approximate++
+		}
+	}
+	if approximate == 0 {
+		t.Errorf("DefaultLimit never gave an approximate diff")
+	}
+}
+
+func TestStats(t *testing.T) {
+	rand.Seed(5)
+	discarded := 0
+	for i := 0; i < 20; i++ {
+		a := []rune(randstr("abcω", 300))
+		b := []rune(randstr("abcω", 300))
+		seqs := RuneSequences(a, b)
+
+		for _, opts := range []Options{{Minimal: true}, {LinearSpace: true}} {
+			diffs, stats := opts.DiffStats(seqs)
+			n := 0
+			for _, d := range diffs {
+				n += d.End - d.Start + d.ReplEnd - d.ReplStart
+			}
+			if want := (Stats{D: n}); stats != want {
+				t.Errorf("%+v: stats = %+v, want %+v", opts, stats, want)
+			}
+		}
+
+		_, stats := Options{}.DiffStats(seqs)
+		if !stats.Approximate || stats.D != DefaultLimit {
+			t.Errorf("limited diff: stats = %+v, want approximate with D = %d", stats, DefaultLimit)
+		}
+
+		for _, algo := range []Algorithm{Patience, Histogram} {
+			if _, stats := (Options{Algorithm: algo, Minimal: true}).DiffStats(seqs); stats.Approximate || stats.Discarded != 0 {
+				t.Errorf("minimal diff with algorithm %d: stats = %+v", algo, stats)
+			}
+		}
+	}
+
+	// Partial searches overlap when the limit is close to the number of
+	// differences, so that some of their common runs are discarded.
+	for i := 0; i < 50; i++ {
+		a := []rune(randstr("abcω", 20))
+		b := []rune(randstr("abcω", 20))
+		_, stats := Options{Limit: 20}.DiffStats(RuneSequences(a, b))
+		discarded += stats.Discarded
+	}
+	if discarded == 0 {
+		t.Errorf("limited diffs never discarded a common run")
+	}
+}
+
+// cancelSeqs cancels a context after a number of comparisons.
+type cancelSeqs struct {
+	Sequences
+	cancel func()
+	n      *int
+}
+
+func (s cancelSeqs) CommonPrefixLen(ai, aj, bi, bj int) int {
+	if *s.n--; *s.n == 0 {
+		s.cancel()
+	}
+	return s.Sequences.CommonPrefixLen(ai, aj, bi, bj)
+}
+
+func TestDiffContext(t *testing.T) {
+	rand.Seed(6)
+	a := []rune(randstr("abcω", 500))
+	b := []rune(randstr("abcω", 500))
+	seqs := RuneSequences(a, b)
+
+	diffs, err := DiffContext(context.Background(), seqs)
+	if err != nil || fmt.Sprint(diffs) != fmt.Sprint(DiffSequences(seqs)) {
+		t.Errorf("DiffContext(Background) = %v, %v; want %v, nil", diffs, err, DiffSequences(seqs))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, algo := range []Algorithm{Myers, Patience, Histogram} {
+		diffs, err := Options{Algorithm: algo, Minimal: true}.DiffContext(ctx, seqs)
+		if err != context.Canceled {
+			t.Errorf("algorithm %d: DiffContext(canceled) error = %v, want %v", algo, err, context.Canceled)
+		}
+		checkDiffs(t, a, b, diffs)
+	}
+
+	// Cancellation during the search stops it early.
+	for _, opts := range []Options{{Minimal: true}, {LinearSpace: true}} {
+		ctx, cancel := context.WithCancel(context.Background())
+		n := 200
+		diffs, err := opts.DiffContext(ctx, cancelSeqs{seqs, cancel, &n})
+		cancel()
+		if err != context.Canceled {
+			t.Errorf("%+v: DiffContext error = %v, want %v", opts, err, context.Canceled)
+		}
+		checkDiffs(t, a, b, diffs)
+		if n < -1000 {
+			t.Errorf("%+v: %d comparisons after cancellation", opts, -n)
+		}
+	}
+
+	// Patience and Histogram also stop while matching elements that they
+	// never pass to Myers' algorithm, such as the unique ones here.
+	a, b = nil, nil
+	for i := rune(0); i < 1000; i++ {
+		a = append(a, 0x100+i)
+		if i%10 != 0 {
+			b = append(b, 0x100+i)
+		}
+	}
+	seqs = RuneSequences(a, b)
+	for _, algo := range []Algorithm{Patience, Histogram} {
+		opts := Options{Algorithm: algo}
+		if diffs, err := opts.DiffContext(context.Background(), seqs); err != nil || len(diffs) != 100 {
+			t.Errorf("algorithm %d: DiffContext(Background) = %d diffs, %v; want 100, nil", algo, len(diffs), err)
+		}
+		diffs, err := opts.DiffContext(ctx, seqs)
+		if err != context.Canceled {
+			t.Errorf("algorithm %d: DiffContext(canceled) error = %v, want %v", algo, err, context.Canceled)
+		}
+		checkDiffs(t, a, b, diffs)
+		if len(diffs) != 1 {
+			t.Errorf("algorithm %d: DiffContext(canceled) = %v, want a single replacement", algo, diffs)
+		}
+	}
+}
+
 // This benchmark represents a common case for a diff command:
 // large file with a single relatively small diff in the middle.
 // (It's not clear whether this is representative of gopls workloads
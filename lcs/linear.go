@@ -0,0 +1,138 @@
+package lcs
+
+// Linear-space Myers, from section 4b of Myers' paper: the search runs
+// forward and backward at once until the paths overlap, which finds the
+// middle snake of an optimal path, and the parts of the edit graph
+// before and after it are searched recursively. Only the furthest
+// reaching paths of the current D are kept, so memory is linear in the
+// length of the sequences, and the result is always minimal.
+
+// A linearSpace is the state of a linear-space search.
+type linearSpace struct {
+	seqs   Sequences
+	vf, vb []int           // x of the furthest reaching forward and backward paths, by diagonal
+	done   <-chan struct{} // closed to stop the search early, or nil
+
+	diffs       []Diff
+	interrupted bool  // whether the search was stopped by done
+	stats       Stats // statistics of the search
+}
+
+// myersLinear returns the differences between the two sequences of seqs
+// found by linear-space Myers, and adds to its statistics.
+func (s *search) myersLinear(seqs Sequences) []Diff {
+	alen, blen := seqs.Lengths()
+	size := 2*((alen+blen+1)/2) + 3
+	l := &linearSpace{
+		seqs: seqs,
+		vf:   make([]int, size),
+		vb:   make([]int, size),
+		done: s.done,
+	}
+	l.diff(0, alen, 0, blen)
+	s.stats.add(l.stats)
+	s.interrupted = s.interrupted || l.interrupted
+	return l.diffs
+}
+
+// diff appends the differences between A[ax:aend] and B[by:bend].
+func (l *linearSpace) diff(ax, aend, by, bend int) {
+	n := l.seqs.CommonPrefixLen(ax, aend, by, bend)
+	ax, by = ax+n, by+n
+	n = l.seqs.CommonSuffixLen(ax, aend, by, bend)
+	aend, bend = aend-n, bend-n
+	if ax == aend || by == bend {
+		l.change(ax, aend, by, bend)
+		return
+	}
+
+	// With no common prefix or suffix, the edit distance is at least 2,
+	// so both parts either side of the middle snake are smaller.
+	x, y, u, v, ok := l.middleSnake(ax, aend, by, bend)
+	if !ok {
+		l.interrupted = true
+		l.stats.Approximate = true
+		l.change(ax, aend, by, bend)
+		return
+	}
+	l.diff(ax, x, by, y)
+	l.diff(u, aend, v, bend)
+}
+
+// change appends the replacement of A[ax:aend] by B[by:bend], if not
+// empty, merging it with a preceding adjacent change.
+func (l *linearSpace) change(ax, aend, by, bend int) {
+	if ax == aend && by == bend {
+		return
+	}
+	l.stats.D += aend - ax + bend - by
+	if n := len(l.diffs); n > 0 && l.diffs[n-1].End == ax && l.diffs[n-1].ReplEnd == by {
+		l.diffs[n-1].End, l.diffs[n-1].ReplEnd = aend, bend
+		return
+	}
+	l.diffs = append(l.diffs, Diff{ax, aend, by, bend})
+}
+
+// middleSnake returns the middle snake, from (x, y) to (u, v), of an
+// optimal path through the edit graph of A[ax:aend] and B[by:bend].
+// It reports false if the search was stopped early.
+func (l *linearSpace) middleSnake(ax, aend, by, bend int) (x, y, u, v int, ok bool) {
+	n, m := aend-ax, bend-by
+	delta := n - m
+	odd := delta%2 != 0
+	maxD := (n + m + 1) / 2
+	// vf[off+k] is the x of the furthest forward path on diagonal k = x-y,
+	// relative to (ax, by); vb[off+k] is the distance back from (aend, bend)
+	// along A of the furthest backward path on reverse diagonal k.
+	off := maxD + 1
+	vf, vb := l.vf, l.vb
+	vf[off+1], vb[off+1] = 0, 0
+	for d := 0; d <= maxD; d++ {
+		select {
+		case <-l.done:
+			return 0, 0, 0, 0, false
+		default:
+		}
+
+		// Extend the forward paths.
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && vf[off+k-1] < vf[off+k+1] {
+				x = vf[off+k+1] // down
+			} else {
+				x = vf[off+k-1] + 1 // right
+			}
+			y := x - k
+			x0, y0 := x, y
+			if x < n && y < m {
+				s := l.seqs.CommonPrefixLen(ax+x, aend, by+y, bend)
+				x, y = x+s, y+s
+			}
+			vf[off+k] = x
+			if kr := delta - k; odd && -(d-1) <= kr && kr <= d-1 && x+vb[off+kr] >= n {
+				return ax + x0, by + y0, ax + x, by + y, true
+			}
+		}
+
+		// Extend the backward paths.
+		for kr := -d; kr <= d; kr += 2 {
+			var xr int
+			if kr == -d || kr != d && vb[off+kr-1] < vb[off+kr+1] {
+				xr = vb[off+kr+1]
+			} else {
+				xr = vb[off+kr-1] + 1
+			}
+			yr := xr - kr
+			xr0, yr0 := xr, yr
+			if xr < n && yr < m {
+				s := l.seqs.CommonSuffixLen(ax, aend-xr, by, bend-yr)
+				xr, yr = xr+s, yr+s
+			}
+			vb[off+kr] = xr
+			if k := delta - kr; !odd && -d <= k && k <= d && vf[off+k]+xr >= n {
+				return aend - xr, bend - yr, aend - xr0, bend - yr0, true
+			}
+		}
+	}
+	panic("no middle snake") // can't happen: the paths meet by maxD
+}
@@ -0,0 +1,124 @@
+package lcs
+
+import "sort"
+
+// Patience diff, as described by Bram Cohen: the elements that occur
+// exactly once in each sequence are matched, the longest run of those
+// matches that is in the same order in both sequences anchors the
+// alignment, and the gaps between anchors are diffed recursively.
+// Gaps without unique elements are diffed by Myers' algorithm.
+
+// A match pairs A[x] with an equal B[y].
+type match struct{ x, y int }
+
+// patience appends to diffs the differences between the sequences of
+// keys a and b, which start at offsets aoff and boff in the complete
+// sequences.
+func (s *search) patience(diffs []Diff, a, b []int, aoff, boff int) []Diff {
+	a, b, aoff, boff = trimCommon(a, b, aoff, boff)
+	if len(a) == 0 || len(b) == 0 {
+		return appendChange(diffs, a, b, aoff, boff)
+	}
+	if s.stopped() {
+		// Out of time: replace all that is left.
+		return appendChange(diffs, a, b, aoff, boff)
+	}
+
+	anchors := longestIncreasing(uniqueMatches(a, b))
+	if len(anchors) == 0 {
+		return s.myersKeys(diffs, a, b, aoff, boff)
+	}
+	x, y := 0, 0
+	for _, m := range anchors {
+		diffs = s.patience(diffs, a[x:m.x], b[y:m.y], aoff+x, boff+y)
+		x, y = m.x+1, m.y+1
+	}
+	return s.patience(diffs, a[x:], b[y:], aoff+x, boff+y)
+}
+
+// uniqueMatches returns the matches between elements that occur exactly
+// once in each of a and b, in increasing order of x.
+func uniqueMatches(a, b []int) []match {
+	type count struct{ na, nb, x, y int }
+	counts := make(map[int]*count)
+	for x, k := range a {
+		c := counts[k]
+		if c == nil {
+			c = new(count)
+			counts[k] = c
+		}
+		c.na++
+		c.x = x
+	}
+	for y, k := range b {
+		if c := counts[k]; c != nil {
+			c.nb++
+			c.y = y
+		}
+	}
+	var matches []match
+	for x, k := range a {
+		if c := counts[k]; c.na == 1 && c.nb == 1 {
+			matches = append(matches, match{x, c.y})
+		}
+	}
+	return matches
+}
+
+// longestIncreasing returns the longest subsequence of ms, which is in
+// increasing order of x, that is also in increasing order of y.
+// The y values of ms must be distinct.
+func longestIncreasing(ms []match) []match {
+	if len(ms) == 0 {
+		return nil
+	}
+	// Patience sorting: tops[k] is the index in ms of the top card of
+	// pile k, and prev[i] is the top of pile k-1 when ms[i] was placed.
+	var tops []int
+	prev := make([]int, len(ms))
+	for i, m := range ms {
+		k := sort.Search(len(tops), func(k int) bool { return ms[tops[k]].y > m.y })
+		prev[i] = -1
+		if k > 0 {
+			prev[i] = tops[k-1]
+		}
+		if k == len(tops) {
+			tops = append(tops, i)
+		} else {
+			tops[k] = i
+		}
+	}
+	lis := make([]match, len(tops))
+	for i, k := tops[len(tops)-1], len(tops)-1; k >= 0; i, k = prev[i], k-1 {
+		lis[k] = ms[i]
+	}
+	return lis
+}
+
+// trimCommon removes the common prefix and suffix of a and b,
+// advancing their offsets aoff and boff past the prefix.
+func trimCommon(a, b []int, aoff, boff int) ([]int, []int, int, int) {
+	n := commonPrefixLenSlices(a, b)
+	a, b = a[n:], b[n:]
+	aoff, boff = aoff+n, boff+n
+	n = commonSuffixLenSlices(a, b)
+	return a[:len(a)-n], b[:len(b)-n], aoff, boff
+}
+
+// appendChange appends to diffs the replacement of all of a by all of b,
+// unless both are empty.
+func appendChange(diffs []Diff, a, b []int, aoff, boff int) []Diff {
+	if len(a) == 0 && len(b) == 0 {
+		return diffs
+	}
+	return append(diffs, Diff{aoff, aoff + len(a), boff, boff + len(b)})
+}
+
+// myersKeys appends to diffs the differences between a and b found by
+// Myers' algorithm, offset by aoff and boff.
+func (s *search) myersKeys(diffs []Diff, a, b []int, aoff, boff int) []Diff {
+	for _, d := range s.myers(slicesSeqs[int]{a, b}) {
+		diffs = append(diffs, Diff{d.Start + aoff, d.End + aoff, d.ReplStart + boff, d.ReplEnd + boff})
+	}
+	return diffs
+}
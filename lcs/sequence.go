@@ -1,24 +1,59 @@
 package lcs
 
-// sequences abstracts a pair of sequences, A and B.
-type sequences interface {
-	lengths() (int, int)                    // len(A), len(B)
-	commonPrefixLen(ai, aj, bi, bj int) int // len(commonPrefix(A[ai:aj], B[bi:bj]))
-	commonSuffixLen(ai, aj, bi, bj int) int // len(commonSuffix(A[ai:aj], B[bi:bj]))
+// Sequences abstracts a pair of sequences, A and B, so that they can be
+// compared without being held in memory as slices. Implementations are
+// called with 0 <= ai <= aj <= len(A) and 0 <= bi <= bj <= len(B).
+type Sequences interface {
+	Lengths() (int, int)                    // len(A), len(B)
+	CommonPrefixLen(ai, aj, bi, bj int) int // len(commonPrefix(A[ai:aj], B[bi:bj]))
+	CommonSuffixLen(ai, aj, bi, bj int) int // len(commonSuffix(A[ai:aj], B[bi:bj]))
 }
 
+// KeyedSequences is implemented by Sequences that can describe each
+// element by an integer key. Algorithms that must find equal elements
+// anywhere in the sequences, such as Patience, require it.
+type KeyedSequences interface {
+	Sequences
+	// Keys returns a key for each element of A and B, such that two
+	// elements are equal if and only if their keys are equal.
+	Keys() (a, b []int)
+}
+
+// ByteSequences returns the sequences of the bytes of a and b.
+func ByteSequences(a, b []byte) KeyedSequences { return bytesSeqs{a, b} }
+
+// RuneSequences returns the sequences of the runes of a and b.
+func RuneSequences(a, b []rune) KeyedSequences { return runesSeqs{a, b} }
+
+// SliceSequences returns the sequences of the elements of a and b.
+func SliceSequences[T comparable](a, b []T) KeyedSequences { return slicesSeqs[T]{a, b} }
+
+// FuncSequences returns the sequences of the elements of a and b,
+// using eq to decide whether two elements are equal.
+func FuncSequences[T any](a, b []T, eq func(T, T) bool) Sequences { return funcSeqs[T]{a, b, eq} }
+
 // The explicit capacity in s[i:j:j] leads to more efficient code.
 
 type bytesSeqs struct{ a, b []byte }
 
-func (s bytesSeqs) lengths() (int, int) { return len(s.a), len(s.b) }
-func (s bytesSeqs) commonPrefixLen(ai, aj, bi, bj int) int {
+func (s bytesSeqs) Lengths() (int, int) { return len(s.a), len(s.b) }
+func (s bytesSeqs) CommonPrefixLen(ai, aj, bi, bj int) int {
 	return commonPrefixLenBytes(s.a[ai:aj:aj], s.b[bi:bj:bj])
 }
-func (s bytesSeqs) commonSuffixLen(ai, aj, bi, bj int) int {
+func (s bytesSeqs) CommonSuffixLen(ai, aj, bi, bj int) int {
 	return commonSuffixLenBytes(s.a[ai:aj:aj], s.b[bi:bj:bj])
 }
 
+func (s bytesSeqs) Keys() ([]int, []int) { return bytesKeys(s.a), bytesKeys(s.b) }
+
+func bytesKeys(s []byte) []int {
+	keys := make([]int, len(s))
+	for i, c := range s {
+		keys[i] = int(c)
+	}
+	return keys
+}
+
 // commonPrefixLen* returns the length of the common prefix of a[ai:aj] and b[bi:bj].
 func commonPrefixLenBytes(a, b []byte) int {
 	n := min(len(a), len(b))
@@ -41,14 +76,24 @@ func commonSuffixLenBytes(a, b []byte) int {
 
 type runesSeqs struct{ a, b []rune }
 
-func (s runesSeqs) lengths() (int, int) { return len(s.a), len(s.b) }
-func (s runesSeqs) commonPrefixLen(ai, aj, bi, bj int) int {
+func (s runesSeqs) Lengths() (int, int) { return len(s.a), len(s.b) }
+func (s runesSeqs) CommonPrefixLen(ai, aj, bi, bj int) int {
 	return commonPrefixLenRunes(s.a[ai:aj:aj], s.b[bi:bj:bj])
 }
-func (s runesSeqs) commonSuffixLen(ai, aj, bi, bj int) int {
+func (s runesSeqs) CommonSuffixLen(ai, aj, bi, bj int) int {
 	return commonSuffixLenRunes(s.a[ai:aj:aj], s.b[bi:bj:bj])
 }
 
+func (s runesSeqs) Keys() ([]int, []int) { return runesKeys(s.a), runesKeys(s.b) }
+
+func runesKeys(s []rune) []int {
+	keys := make([]int, len(s))
+	for i, r := range s {
+		keys[i] = int(r)
+	}
+	return keys
+}
+
 func commonPrefixLenRunes(a, b []rune) int {
 	n := min(len(a), len(b))
 	i := 0
@@ -66,3 +111,76 @@ func commonSuffixLenRunes(a, b []rune) int {
 	}
 	return i
 }
+
+type slicesSeqs[T comparable] struct{ a, b []T }
+
+func (s slicesSeqs[T]) Lengths() (int, int) { return len(s.a), len(s.b) }
+func (s slicesSeqs[T]) CommonPrefixLen(ai, aj, bi, bj int) int {
+	return commonPrefixLenSlices(s.a[ai:aj:aj], s.b[bi:bj:bj])
+}
+func (s slicesSeqs[T]) CommonSuffixLen(ai, aj, bi, bj int) int {
+	return commonSuffixLenSlices(s.a[ai:aj:aj], s.b[bi:bj:bj])
+}
+
+func (s slicesSeqs[T]) Keys() ([]int, []int) {
+	ids := make(map[T]int)
+	return internSlice(ids, s.a), internSlice(ids, s.b)
+}
+
+// internSlice returns the ID of each element of s, numbering elements
+// not already in ids in order of appearance.
+func internSlice[T comparable](ids map[T]int, s []T) []int {
+	keys := make([]int, len(s))
+	for i, x := range s {
+		id, ok := ids[x]
+		if !ok {
+			id = len(ids)
+			ids[x] = id
+		}
+		keys[i] = id
+	}
+	return keys
+}
+
+func commonPrefixLenSlices[T comparable](a, b []T) int {
+	n := min(len(a), len(b))
+	i := 0
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+func commonSuffixLenSlices[T comparable](a, b []T) int {
+	n := min(len(a), len(b))
+	i := 0
+	for i < n && a[len(a)-1-i] == b[len(b)-1-i] {
+		i++
+	}
+	return i
+}
+
+type funcSeqs[T any] struct {
+	a, b []T
+	eq   func(T, T) bool
+}
+
+func (s funcSeqs[T]) Lengths() (int, int) { return len(s.a), len(s.b) }
+func (s funcSeqs[T]) CommonPrefixLen(ai, aj, bi, bj int) int {
+	a, b := s.a[ai:aj:aj], s.b[bi:bj:bj]
+	n := min(len(a), len(b))
+	i := 0
+	for i < n && s.eq(a[i], b[i]) {
+		i++
+	}
+	return i
+}
+func (s funcSeqs[T]) CommonSuffixLen(ai, aj, bi, bj int) int {
+	a, b := s.a[ai:aj:aj], s.b[bi:bj:bj]
+	n := min(len(a), len(b))
+	i := 0
+	for i < n && s.eq(a[len(a)-1-i], b[len(b)-1-i]) {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,224 @@
+package diff
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/glaslos/diff/lcs"
+)
+
+// Lines computes the differences between two strings line by line.
+// Each resulting edit replaces whole lines of before by whole lines of
+// after; a final line without a newline differs from the same line with
+// one.
+func Lines(before, after string) []Edit {
+	return Options{}.Lines(before, after)
+}
+
+// Lines computes the differences between two strings line by line.
+// Each resulting edit replaces whole lines of before by whole lines of
+// after; a final line without a newline differs from the same line with
+// one.
+func (o Options) Lines(before, after string) []Edit {
+	if before == after {
+		return nil // common case
+	}
+	edits := o.diffTokens(splitLines(before), splitLines(after), o.lineKey)
+	if o.IndentHeuristic {
+		var err error
+		edits, err = IndentHeuristic(before, edits)
+		if err != nil {
+			panic(err) // can't happen: edits are consistent
+		}
+	}
+	return edits
+}
+
+// Words computes the differences between two strings word by word, with
+// words delimited by tok as for WordEdits. Each resulting edit replaces
+// whole words of before, with their boundaries, by whole words of after.
+func Words(before, after string, tok Tokenizer) []Edit {
+	return Options{}.Words(before, after, tok)
+}
+
+// Words computes the differences between two strings word by word, with
+// words delimited by tok as for WordEdits. Each resulting edit replaces
+// whole words of before, with their boundaries, by whole words of after.
+func (o Options) Words(before, after string, tok Tokenizer) []Edit {
+	if before == after {
+		return nil // common case
+	}
+	return o.diffTokens(splitTokens(before, tok), splitTokens(after, tok), o.wordKey)
+}
+
+// diffTokens computes the differences between two texts divided into
+// tokens a and b, comparing tokens by the text returned by key and
+// passing over those for which it returns false.
+func (o Options) diffTokens(a, b []string, key func(string) (string, bool)) []Edit {
+	// Intern each distinct key so that tokens compare as integers.
+	ids := make(map[string]int)
+	akeys, aindex := internTokens(ids, a, key)
+	bkeys, bindex := internTokens(ids, b, key)
+	diffs := o.lcsOptions().DiffSequences(lcs.SliceSequences(akeys, bkeys))
+	if o.Normalizer != nil {
+		diffs = o.replaceUnequal(diffs, a, b, aindex, bindex)
+	}
+
+	// Convert indexes of compared tokens to byte offsets.
+	var edits []Edit
+	offset, token := 0, 0
+	for _, d := range diffs {
+		start, end := tokensRange(aindex, d.Start, d.End)
+		rstart, rend := tokensRange(bindex, d.ReplStart, d.ReplEnd)
+		offset += tokensLen(a[token:start])
+		edit := Edit{Start: offset}
+		offset += tokensLen(a[start:end])
+		edit.End = offset
+		edit.New = strings.Join(b[rstart:rend], "")
+		edits = append(edits, edit)
+		token = end
+	}
+	return edits
+}
+
+// internTokens returns the ID of the key of each token that key does not
+// pass over, numbering keys not already in ids in order of appearance,
+// and the index of each such token.
+func internTokens(ids map[string]int, tokens []string, key func(string) (string, bool)) (keys, index []int) {
+	for i, t := range tokens {
+		k, ok := key(t)
+		if !ok {
+			continue
+		}
+		id, ok := ids[k]
+		if !ok {
+			id = len(ids)
+			ids[k] = id
+		}
+		keys = append(keys, id)
+		index = append(index, i)
+	}
+	return keys, index
+}
+
+// replaceUnequal adds to diffs the replacement of each pair of matched
+// tokens that still differ apart from ignored white space, so that the
+// differences hidden by the Normalizer are not lost. Adjacent diffs are
+// merged.
+func (o Options) replaceUnequal(diffs []lcs.Diff, a, b []string, aindex, bindex []int) []lcs.Diff {
+	var res []lcs.Diff
+	add := func(d lcs.Diff) {
+		if n := len(res); n > 0 && res[n-1].End == d.Start && res[n-1].ReplEnd == d.ReplStart {
+			res[n-1].End, res[n-1].ReplEnd = d.End, d.ReplEnd
+			return
+		}
+		res = append(res, d)
+	}
+	i, j := 0, 0 // next compared tokens of a and b
+	matched := func(end int) {
+		for ; i < end; i, j = i+1, j+1 {
+			if o.normalizeSpace(a[aindex[i]]) != o.normalizeSpace(b[bindex[j]]) {
+				add(lcs.Diff{Start: i, End: i + 1, ReplStart: j, ReplEnd: j + 1})
+			}
+		}
+	}
+	for _, d := range diffs {
+		matched(d.Start)
+		add(d)
+		i, j = d.End, d.ReplEnd
+	}
+	matched(len(aindex))
+	return res
+}
+
+// tokensRange returns the range of tokens spanned by the compared tokens
+// i to j, whose indexes are given by index. If the range is empty it
+// follows the compared token before i, or is at the start of the text.
+func tokensRange(index []int, i, j int) (start, end int) {
+	if i < j {
+		return index[i], index[j-1] + 1
+	}
+	if i > 0 {
+		return index[i-1] + 1, index[i-1] + 1
+	}
+	return 0, 0
+}
+
+// lineKey returns the text by which Options.Lines compares line,
+// or false if the line is ignored.
+func (o Options) lineKey(line string) (string, bool) {
+	if o.IgnoreBlankLines && strings.TrimSpace(line) == "" {
+		return "", false
+	}
+	return o.normalize(line), true
+}
+
+// wordKey returns the text by which Options.Words compares word,
+// or false if the word is ignored because it is only white space.
+func (o Options) wordKey(word string) (string, bool) {
+	if (o.IgnoreSpaceChange || o.IgnoreAllSpace) && strings.TrimSpace(word) == "" {
+		return "", false
+	}
+	return o.normalize(word), true
+}
+
+// normalize returns the image of s under the Normalizer, if any,
+// without the differences in white space that o ignores.
+func (o Options) normalize(s string) string {
+	if o.Normalizer != nil {
+		s = o.Normalizer(s)
+	}
+	return o.normalizeSpace(s)
+}
+
+// normalizeSpace returns s without the differences in white space that
+// o ignores.
+func (o Options) normalizeSpace(s string) string {
+	switch {
+	case o.IgnoreAllSpace:
+		return strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) {
+				return -1
+			}
+			return r
+		}, s)
+	case o.IgnoreSpaceChange:
+		var b strings.Builder
+		space := false
+		for _, r := range s {
+			if unicode.IsSpace(r) {
+				space = true
+				continue
+			}
+			if space {
+				b.WriteByte(' ')
+				space = false
+			}
+			b.WriteRune(r)
+		}
+		return b.String()
+	}
+	return s
+}
+
+// FoldCase returns s with each rune replaced by the least rune that it
+// matches under Unicode simple case folding, so that FoldCase(s) equals
+// FoldCase(t) exactly when strings.EqualFold(s, t). It is suitable as
+// Options.Normalizer for comparisons that ignore case.
+func FoldCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		least := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			least = min(least, f)
+		}
+		return least
+	}, s)
+}
+
+// tokensLen returns the total length in bytes of tokens.
+func tokensLen(tokens []string) (n int) {
+	for _, t := range tokens {
+		n += len(t)
+	}
+	return n
+}
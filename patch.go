@@ -0,0 +1,240 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultContextLines is the number of unchanged lines of surrounding
+// context displayed by ToUnified when callers have no preference.
+const DefaultContextLines = 3
+
+// ToUnified applies the edits to content and returns a unified diff,
+// with contextLines lines of (unchanged) context around each diff hunk.
+// The old and new labels are the names of the content and result files.
+// It returns an error if the edits are inconsistent; see Apply.
+func ToUnified(oldLabel, newLabel, content string, edits []Edit, contextLines int) (string, error) {
+	p, err := toPatch(oldLabel, newLabel, content, edits, contextLines)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
+// patch represents a set of edits as a line-oriented unified diff.
+type patch struct {
+	// from is the name of the original file.
+	from string
+	// to is the name of the modified file.
+	to string
+	// hunks is the set of edit hunks needed to transform the file content.
+	hunks []*hunk
+}
+
+// hunk represents a contiguous set of line edits to apply.
+type hunk struct {
+	// The line in the original source where the hunk starts.
+	fromLine int
+	// The line in the modified source where the hunk starts.
+	toLine int
+	// The set of line based edits to apply.
+	lines []line
+}
+
+// line represents a single line operation to apply as part of a hunk.
+type line struct {
+	// kind is the type of line this represents, deletion, insertion or copy.
+	kind Kind
+	// content is the content of this line, including its newline if any.
+	// For deletion it is the line being removed, for all others it is the line
+	// to put in the output.
+	content string
+}
+
+// toPatch takes a file contents and a sequence of edits, and calculates
+// a unified diff that represents those edits.
+func toPatch(fromName, toName string, content string, edits []Edit, contextLines int) (patch, error) {
+	gap := contextLines * 2
+	p := patch{
+		from: fromName,
+		to:   toName,
+	}
+	if len(edits) == 0 {
+		return p, nil
+	}
+	var err error
+	edits, err = LineEdits(content, edits) // expand to whole lines
+	if err != nil {
+		return p, err
+	}
+	lines := splitLines(content)
+	var h *hunk
+	last := 0
+	toLine := 0
+	newlines, pos := 0, 0 // number of newlines before offset pos
+	for _, edit := range edits {
+		// Compute the zero-based line numbers of the edit start and end,
+		// counting on from the previous edit.
+		newlines += strings.Count(content[pos:edit.Start], "\n")
+		start := newlines
+		newlines += strings.Count(content[edit.Start:edit.End], "\n")
+		end := newlines
+		pos = edit.End
+		if edit.End == len(content) && len(content) > 0 && content[len(content)-1] != '\n' {
+			end++ // EOF counts as an implicit newline
+		}
+
+		// Widening to whole lines may have pulled in lines that
+		// the edit leaves unchanged; don't report them as changes.
+		var inserted []string
+		if edit.New != "" {
+			inserted = splitLines(edit.New)
+		}
+		for start < end && len(inserted) > 0 && lines[start] == inserted[0] {
+			start++
+			inserted = inserted[1:]
+		}
+		for start < end && len(inserted) > 0 && lines[end-1] == inserted[len(inserted)-1] {
+			end--
+			inserted = inserted[:len(inserted)-1]
+		}
+
+		switch {
+		case h != nil && start == last:
+			//direct extension
+		case h != nil && start <= last+gap:
+			//within range of previous lines, add the joiners
+			addEqualLines(h, lines, last, start)
+			toLine += start - last
+		default:
+			//need to start a new hunk
+			if h != nil {
+				// add the edge to the previous hunk
+				addEqualLines(h, lines, last, last+contextLines)
+				p.hunks = append(p.hunks, h)
+			}
+			toLine += start - last
+			h = &hunk{
+				fromLine: start + 1,
+				toLine:   toLine + 1,
+			}
+			// add the edge to the new hunk
+			delta := addEqualLines(h, lines, start-contextLines, start)
+			h.fromLine -= delta
+			h.toLine -= delta
+		}
+		last = start
+		for i := start; i < end; i++ {
+			h.lines = append(h.lines, line{kind: Delete, content: lines[i]})
+			last++
+		}
+		for _, content := range inserted {
+			h.lines = append(h.lines, line{kind: Insert, content: content})
+			toLine++
+		}
+	}
+	if h != nil {
+		// add the edge to the final hunk
+		addEqualLines(h, lines, last, last+contextLines)
+		p.hunks = append(p.hunks, h)
+	}
+	return p, nil
+}
+
+// splitLines splits text after each newline.
+// A final line without a newline is retained.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func addEqualLines(h *hunk, lines []string, start, end int) int {
+	delta := 0
+	for i := start; i < end; i++ {
+		if i < 0 {
+			continue
+		}
+		if i >= len(lines) {
+			return delta
+		}
+		h.lines = append(h.lines, line{kind: Equal, content: lines[i]})
+		delta++
+	}
+	return delta
+}
+
+// String converts a unified diff to the standard textual form for that diff.
+// The output of this function can be passed to tools like patch.
+func (p patch) String() string {
+	if len(p.hunks) == 0 {
+		return ""
+	}
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "--- %s\n", p.from)
+	fmt.Fprintf(b, "+++ %s\n", p.to)
+	for _, h := range p.hunks {
+		b.WriteString(h.header())
+		b.WriteString("\n")
+		for _, l := range h.lines {
+			b.WriteString(lineString(l))
+		}
+	}
+	return b.String()
+}
+
+// lineString returns the text of l in a unified diff: its content with
+// a prefix that denotes its kind, followed by a marker if it does not
+// end with a newline.
+func lineString(l line) string {
+	prefix := " "
+	switch l.kind {
+	case Delete:
+		prefix = "-"
+	case Insert:
+		prefix = "+"
+	}
+	if !strings.HasSuffix(l.content, "\n") {
+		return prefix + l.content + "\n\\ No newline at end of file\n"
+	}
+	return prefix + l.content
+}
+
+// header returns the "@@ -l,s +l,s @@" line that introduces the hunk,
+// without a trailing newline.
+func (h *hunk) header() string {
+	fromCount, toCount := 0, 0
+	for _, l := range h.lines {
+		switch l.kind {
+		case Delete:
+			fromCount++
+		case Insert:
+			toCount++
+		default:
+			fromCount++
+			toCount++
+		}
+	}
+	b := new(strings.Builder)
+	fmt.Fprint(b, "@@")
+	writeRange(b, '-', h.fromLine, fromCount)
+	writeRange(b, '+', h.toLine, toCount)
+	fmt.Fprint(b, " @@")
+	return b.String()
+}
+
+// writeRange writes one side of a hunk header in the form used by GNU
+// diff -u: the count is omitted when it is 1, and an empty range names
+// the line before it, so insertion into an empty file reads "-0,0".
+func writeRange(b *strings.Builder, sign byte, start, count int) {
+	switch count {
+	case 0:
+		fmt.Fprintf(b, " %c%d,0", sign, start-1)
+	case 1:
+		fmt.Fprintf(b, " %c%d", sign, start)
+	default:
+		fmt.Fprintf(b, " %c%d,%d", sign, start, count)
+	}
+}
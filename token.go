@@ -0,0 +1,84 @@
+package diff
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A Tokenizer divides text into the words used by WordEdits and Unified.
+//
+// Every boundary rune ends the word before it and belongs to it, so two
+// adjacent boundaries delimit an empty word, just as two adjacent
+// newlines delimit an empty line.
+type Tokenizer interface {
+	// IsBoundary reports whether r separates words.
+	IsBoundary(r rune) bool
+}
+
+var (
+	// Spaces separates words at each ASCII space.
+	Spaces Tokenizer = separators(" ")
+
+	// Whitespace separates words at each Unicode white space rune,
+	// including tabs and newlines.
+	Whitespace Tokenizer = whitespace{}
+)
+
+// Separators returns a Tokenizer that separates words at each rune of set.
+func Separators(set string) Tokenizer {
+	return separators(set)
+}
+
+type separators string
+
+func (s separators) IsBoundary(r rune) bool { return strings.ContainsRune(string(s), r) }
+
+type whitespace struct{}
+
+func (whitespace) IsBoundary(r rune) bool { return unicode.IsSpace(r) }
+
+// splitTokens splits text after each boundary rune of tok, so that each
+// token is a word followed by its boundary. A final word without a
+// boundary is retained. Concatenating the tokens yields text.
+func splitTokens(text string, tok Tokenizer) []string {
+	var tokens []string
+	for len(text) > 0 {
+		i := strings.IndexFunc(text, tok.IsBoundary)
+		if i < 0 {
+			tokens = append(tokens, text)
+			break
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		tokens = append(tokens, text[:i+size])
+		text = text[i+size:]
+	}
+	return tokens
+}
+
+// boundaryOf returns the boundary that ends token, or "" if it has none.
+func boundaryOf(token string, tok Tokenizer) string {
+	r, size := utf8.DecodeLastRuneInString(token)
+	if size > 0 && tok.IsBoundary(r) {
+		return token[len(token)-size:]
+	}
+	return ""
+}
+
+// countBoundaries returns the number of boundary runes in s.
+func countBoundaries(s string, tok Tokenizer) int {
+	n := 0
+	for _, r := range s {
+		if tok.IsBoundary(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// endsWord reports whether s is empty or ends with a boundary rune,
+// that is, whether len(s) is the start of a word.
+func endsWord(s string, tok Tokenizer) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return s == "" || tok.IsBoundary(r)
+}
@@ -1,144 +1,274 @@
 package diff
 
 import (
+	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
+// Unified applies the edits to content and renders the result word by
+// word, with words delimited by tok, using f to render each segment;
+// see WordDiff.Format.
+//
+// Words keep the boundaries that follow them in content and in the
+// edited text, so removing the formatted insertions from the output
+// yields content, and removing the deletions yields the edited text.
+// When a replacement ends with the same boundary on both sides, that
+// boundary is rendered once, as an equal segment after the changed words.
+//
+// Unified renders the whole document; use UnifiedContext for an excerpt.
+// It returns an error if the edits are inconsistent; see Apply.
 func Unified(
 	content string, edits []Edit,
-	split func(string) []string,
-	format func(string, bool) string,
+	tok Tokenizer,
+	f Formatter,
 ) (string, error) {
-	u, err := toUnified(content, edits, split)
+	return UnifiedContext(content, edits, tok, f, FullContext, "")
+}
+
+// FullContext, passed as the number of context words to UnifiedContext
+// or ToWordDiff, keeps every unchanged word.
+const FullContext = -1
+
+// UnifiedContext is like Unified, but keeps only contextWords unchanged
+// words on either side of each change. Each omitted stretch of words,
+// including their boundaries, is replaced by elision.
+// If contextWords is negative, the whole document is rendered.
+func UnifiedContext(
+	content string, edits []Edit,
+	tok Tokenizer,
+	f Formatter,
+	contextWords int, elision string,
+) (string, error) {
+	d, err := ToWordDiff(content, edits, tok, contextWords)
 	if err != nil {
 		return "", err
 	}
-	return u.String(format), nil
+	return d.Format(f, elision), nil
 }
 
-// opKind is used to denote the type of operation a line represents.
-type opKind int
+// Kind denotes the type of operation a segment of a diff represents.
+type Kind int
 
 const (
-	// opDelete is the operation kind for a line that is present in the input
+	// Delete is the operation kind for text that is present in the input
 	// but not in the output.
-	opDelete opKind = iota
-	// opInsert is the operation kind for a line that is new in the output.
-	opInsert
-	// opEqual is the operation kind for a line that is the same in the input and
-	// output, often used to provide context around edited lines.
-	opEqual
+	Delete Kind = iota
+	// Insert is the operation kind for text that is new in the output.
+	Insert
+	// Equal is the operation kind for text that is the same in the input and
+	// output, often used to provide context around edited text.
+	Equal
+	// Elided is the operation kind for a stretch of unchanged text that
+	// is left out because it is too far from any change.
+	Elided
 )
 
-// unified represents a set of edits as a unified diff.
-type unified struct {
-	words []word
+// String returns a human readable representation of a Kind.
+// It is not intended for machine processing.
+func (k Kind) String() string {
+	switch k {
+	case Delete:
+		return "delete"
+	case Insert:
+		return "insert"
+	case Equal:
+		return "equal"
+	case Elided:
+		return "elided"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// A WordDiff represents a set of edits as a sequence of word operations
+// that, in order, take the input text to the output text.
+type WordDiff struct {
+	Segments []Segment
 }
 
-// word represents a single word operation to apply as part of a Hunk.
-type word struct {
-	// kind is the type of word this represents, deletion, insertion or copy.
-	kind opKind
-	// content is the content of this word.
-	// For deletion it is the word being removed, for all others it is the word
-	// to put in the output.
-	content string
+// A Segment is a single operation of a WordDiff.
+//
+// Adjacent segments differ in kind: each deleted, inserted and equal
+// segment is a whole run of words with their boundaries, so a change is
+// at most one deletion followed by one insertion. A boundary that ends
+// both sides of a replacement is equal text that begins the following
+// equal segment. An elided segment covers a stretch of unchanged words.
+type Segment struct {
+	// Kind is the type of operation: deletion, insertion, copy or elision.
+	Kind Kind
+	// Text is the text of the segment.
+	// For deletion it is the text being removed, for elision it is the
+	// omitted text, and for all others it is the text to put in the output.
+	Text string
+	// OldOffset and NewOffset are the byte offsets of the segment in the
+	// input and the output. An insertion occupies no space in the input,
+	// and a deletion none in the output.
+	OldOffset, NewOffset int
+	// OldIndex and NewIndex are the numbers of words of the input and
+	// the output that precede the segment.
+	OldIndex, NewIndex int
 }
 
-// toUnified takes a file contents and a sequence of edits, and calculates
-// a unified diff that represents those edits.
-func toUnified(
+// ToWordDiff takes a file contents and a sequence of edits, and
+// calculates the word diff that represents those edits, with words
+// delimited by tok and contextWords unchanged words kept around each
+// change, or all of them if contextWords is negative.
+// It returns an error if the edits are inconsistent; see Apply.
+func ToWordDiff(
 	content string, edits []Edit,
-	split func(string) []string,
-) (*unified, error) {
+	tok Tokenizer,
+	contextWords int,
+) (*WordDiff, error) {
 	if len(edits) == 0 {
-		return nil, nil
+		return &WordDiff{}, nil
 	}
 	var err error
-	edits, err = wordEdits(content, edits) // expand to whole words
+	edits, err = WordEdits(content, edits, tok) // expand to whole words
 	if err != nil {
 		return nil, err
 	}
-	words := split(content)
+	words := splitTokens(content, tok)
 
-	u := &unified{
-		words: make([]word, 0, len(words)),
+	b := &wordDiffBuilder{
+		tok: tok,
+		d: &WordDiff{
+			Segments: make([]Segment, 0, len(words)),
+		},
 	}
 
-	previous := 0
-	toWord := 0
-	for _, edit := range edits {
-		// Compute the zero-based line numbers of the edit start and end.
+	last := 0 // index of the first word not yet added
+	changed := false
+	for i := 0; i < len(edits); i++ {
+		edit := edits[i]
+		// Expansion may leave edits that touch; treat them as one.
+		for i+1 < len(edits) && edits[i+1].Start == edit.End {
+			i++
+			edit.End = edits[i].End
+			edit.New += edits[i].New
+		}
+
+		// Compute the zero-based word numbers of the edit start and end.
 		// TODO(adonovan): opt: compute incrementally, avoid O(n^2).
-		start := strings.Count(content[:edit.Start], " ")
-		end := strings.Count(content[:edit.End], " ")
-		if edit.End == len(content) && len(content) > 0 && content[len(content)-1] != ' ' {
-			end++ // EOF counts as an implicit newline
-		}
-
-		// add all leading words
-		if previous == 0 {
-			addEqualWords(u, words, previous, start)
-		}
-
-		switch {
-		case previous != 0 && start == previous:
-			//direct extension
-		case previous != 0 && start <= previous+2:
-			//within range of previous lines, add the joiners
-			addEqualWords(u, words, previous, start)
-		default:
-			//need to start a new hunk
-			if previous != 0 {
-				// add the edge to the previous hunk
-				addEqualWords(u, words, previous, previous+2)
-				//u.hunks = append(u.hunks, h)
-			}
-			toWord += start - previous
-			// add the edge to the new hunk
-			//delta := addEqualLines(u, words, start-2, start)
-			//h.fromWord -= delta
-			//h.toWord -= delta
-		}
-		previous = start
-		for i := start; i < end; i++ {
-			u.words = append(u.words, word{kind: opDelete, content: words[i]})
-			previous++
-		}
-		if edit.New != "" {
-			for _, content := range split(edit.New) {
-				u.words = append(u.words, word{kind: opInsert, content: content})
-				toWord++
-			}
-		}
-	}
-	if previous != 0 {
-		// add the edge to the final hunk
-		addEqualWords(u, words, previous, len(words))
-		//u.words = append(u.words, h)
-	}
-	return u, nil
+		start := countBoundaries(content[:edit.Start], tok)
+		end := countBoundaries(content[:edit.End], tok)
+		if edit.End == len(content) && !endsWord(content, tok) {
+			end++ // EOF counts as an implicit boundary
+		}
+
+		// Widening to whole words may have pulled in words that
+		// the edit leaves unchanged; don't report them as changes.
+		inserted := splitTokens(edit.New, tok)
+		for start < end && len(inserted) > 0 && words[start] == inserted[0] {
+			start++
+			inserted = inserted[1:]
+		}
+		for start < end && len(inserted) > 0 && words[end-1] == inserted[len(inserted)-1] {
+			end--
+			inserted = inserted[:len(inserted)-1]
+		}
+
+		if start == end && len(inserted) == 0 {
+			continue // no change after all
+		}
+
+		head := contextWords // context after the previous change
+		if !changed {
+			head = 0
+		}
+		changed = true
+		b.addEqual(words, last, start, head, contextWords)
+		b.addChange(words[start:end], inserted)
+		last = end
+	}
+	if !changed {
+		return &WordDiff{}, nil
+	}
+	b.addEqual(words, last, len(words), contextWords, 0)
+	return b.d, nil
 }
 
-func addEqualWords(u *unified, words []string, start, end int) int {
-	delta := 0
-	for i := start; i < end; i++ {
-		if i < 0 {
-			continue
+// A wordDiffBuilder appends segments to a WordDiff,
+// keeping track of their offsets.
+type wordDiffBuilder struct {
+	tok                Tokenizer
+	d                  *WordDiff
+	old, new           int // offsets of the next segment
+	oldIndex, newIndex int // word indexes of the next segment
+}
+
+// add appends a segment for text, which holds the given number of words,
+// or extends the last segment if it is of the same kind.
+func (b *wordDiffBuilder) add(kind Kind, text string, words int) {
+	if text == "" {
+		return
+	}
+	if n := len(b.d.Segments); n > 0 && b.d.Segments[n-1].Kind == kind {
+		b.d.Segments[n-1].Text += text
+	} else {
+		b.d.Segments = append(b.d.Segments, Segment{
+			Kind:      kind,
+			Text:      text,
+			OldOffset: b.old,
+			NewOffset: b.new,
+			OldIndex:  b.oldIndex,
+			NewIndex:  b.newIndex,
+		})
+	}
+	if kind != Insert {
+		b.old += len(text)
+		b.oldIndex += words
+	}
+	if kind != Delete {
+		b.new += len(text)
+		b.newIndex += words
+	}
+}
+
+// addEqual adds the unchanged words[start:end], keeping only
+// the first head and last tail of them and eliding the rest.
+// Negative counts keep every word.
+func (b *wordDiffBuilder) addEqual(words []string, start, end, head, tail int) {
+	if head >= 0 && tail >= 0 && end-start > head+tail {
+		b.addEqual(words, start, start+head, -1, -1)
+		omitted := words[start+head : end-tail]
+		b.add(Elided, strings.Join(omitted, ""), len(omitted))
+		start = end - tail
+	}
+	for _, w := range words[start:end] {
+		b.add(Equal, w, 1)
+	}
+}
+
+// addChange adds the replacement of the deleted words by the inserted ones.
+func (b *wordDiffBuilder) addChange(deleted, inserted []string) {
+	// Split off a boundary common to both sides.
+	common := ""
+	if len(deleted) > 0 && len(inserted) > 0 {
+		d := boundaryOf(deleted[len(deleted)-1], b.tok)
+		if d == boundaryOf(inserted[len(inserted)-1], b.tok) {
+			common = d
 		}
-		if i >= len(words) {
-			return delta
+	}
+	for i, w := range deleted {
+		if i == len(deleted)-1 {
+			w = strings.TrimSuffix(w, common)
+		}
+		b.add(Delete, w, 1)
+	}
+	for i, w := range inserted {
+		if i == len(inserted)-1 {
+			w = strings.TrimSuffix(w, common)
 		}
-		u.words = append(u.words, word{kind: opEqual, content: words[i]})
-		delta++
+		b.add(Insert, w, 1)
 	}
-	return delta
+	b.add(Equal, common, 0) // part of the last word
 }
 
-// wordEdits expands and merges a sequence of edits so that each
-// resulting edit replaces one or more complete word.
-// See ApplyEdits for preconditions.
-func wordEdits(src string, edits []Edit) ([]Edit, error) {
+// WordEdits expands and merges a sequence of edits so that each
+// resulting edit replaces one or more complete words, as delimited by
+// tok, including the boundary that ends each word.
+// See Apply for preconditions.
+func WordEdits(src string, edits []Edit, tok Tokenizer) ([]Edit, error) {
 	edits, _, err := validate(src, edits)
 	if err != nil {
 		return nil, err
@@ -148,9 +278,9 @@ func wordEdits(src string, edits []Edit) ([]Edit, error) {
 	// (This is merely a fast path.)
 	for _, edit := range edits {
 		if edit.Start >= len(src) || // insertion at EOF
-			edit.Start > 0 && src[edit.Start-1] != ' ' || // not at line start
-			edit.End > 0 && src[edit.End-1] != ' ' || // not at line start
-			edit.New != "" && edit.New[len(edit.New)-1] != ' ' { // partial insert
+			!endsWord(src[:edit.Start], tok) || // not at word start
+			!endsWord(src[:edit.End], tok) || // not at word start
+			!endsWord(edit.New, tok) { // partial insert
 			goto expand // slow path
 		}
 	}
@@ -166,37 +296,42 @@ expand:
 	// TODO(adonovan): opt: avoid quadratic cost of string += string.
 	for _, edit := range edits[1:] {
 		between := src[prev.End:edit.Start]
-		if !strings.Contains(between, " ") {
+		if strings.IndexFunc(between, tok.IsBoundary) < 0 {
 			// overlapping words: combine with previous edit.
 			prev.New += between + edit.New
 			prev.End = edit.End
 		} else {
 			// non-overlapping words: flush previous edit.
-			expanded = append(expanded, expandEdit(prev, src))
+			expanded = append(expanded, expandEdit(prev, src, tok))
 			prev = edit
 		}
 	}
-	return append(expanded, expandEdit(prev, src)), nil // flush final edit
+	return append(expanded, expandEdit(prev, src, tok)), nil // flush final edit
 }
 
 // expandEdit returns edit expanded to complete whole words.
-func expandEdit(edit Edit, src string) Edit {
-	// Expand start left to start of line.
-	// (delta is the zero-based column number of start.)
+func expandEdit(edit Edit, src string, tok Tokenizer) Edit {
+	// Expand start left to start of word.
+	// (delta is the byte offset of start within its word.)
 	start := edit.Start
-	if delta := start - 1 - strings.LastIndex(src[:start], " "); delta > 0 {
+	wordStart := 0
+	if i := strings.LastIndexFunc(src[:start], tok.IsBoundary); i >= 0 {
+		_, size := utf8.DecodeRuneInString(src[i:])
+		wordStart = i + size
+	}
+	if delta := start - wordStart; delta > 0 {
 		edit.Start -= delta
 		edit.New = src[start-delta:start] + edit.New
 	}
 
-	// Expand end right to end of line.
+	// Expand end right to end of word.
 	end := edit.End
-	if end > 0 && src[end-1] != ' ' ||
-		edit.New != "" && edit.New[len(edit.New)-1] != ' ' {
-		if nl := strings.IndexByte(src[end:], ' '); nl < 0 {
+	if !endsWord(src[:end], tok) || !endsWord(edit.New, tok) {
+		if i := strings.IndexFunc(src[end:], tok.IsBoundary); i < 0 {
 			edit.End = len(src) // extend to EOF
 		} else {
-			edit.End = end + nl + 1 // extend beyond \n
+			_, size := utf8.DecodeRuneInString(src[end+i:])
+			edit.End = end + i + size // extend beyond boundary
 		}
 	}
 	edit.New += src[end:edit.End]
@@ -204,29 +339,72 @@ func expandEdit(edit Edit, src string) Edit {
 	return edit
 }
 
-// String converts a unified diff to the standard textual form for that diff.
-// The output of this function can be passed to tools like patch.
-func (u unified) String(format func(content string, delete bool) string) string {
-	if len(u.words) == 0 {
-		return ""
-	}
-
-	s := make([]string, 0, len(u.words))
-	for i, l := range u.words {
-		switch l.kind {
-		case opDelete:
-			s = append(s, format(l.content, true))
-		case opInsert:
-			s = append(s, format(l.content, false))
-			if i != len(u.words)-1 {
-				s = append(s, " ") // space after all insertions but the last
-			}
-		default:
-			s = append(s, l.content)
-			if i != len(u.words)-1 {
-				s = append(s, " ") // space after all but the last word
-			}
-		}
-	}
-	return strings.Join(s, "")
+// A Formatter renders the segments of a WordDiff as text.
+type Formatter interface {
+	// Equal renders a segment that is unchanged.
+	Equal(s Segment) string
+	// Delete renders a segment that is present only in the input.
+	Delete(s Segment) string
+	// Insert renders a segment that is present only in the output.
+	Insert(s Segment) string
+	// Replace renders a deleted segment together with the inserted
+	// segment that takes its place.
+	Replace(deleted, inserted Segment) string
+}
+
+// FormatFunc adapts a function that formats deleted (delete=true) and
+// inserted text to the Formatter interface. Equal text is copied, and a
+// replacement is formatted as a deletion followed by an insertion.
+type FormatFunc func(text string, delete bool) string
+
+func (f FormatFunc) Equal(s Segment) string  { return s.Text }
+func (f FormatFunc) Delete(s Segment) string { return f(s.Text, true) }
+func (f FormatFunc) Insert(s Segment) string { return f(s.Text, false) }
+func (f FormatFunc) Replace(deleted, inserted Segment) string {
+	return f(deleted.Text, true) + f(inserted.Text, false)
+}
+
+// Format renders the word diff as text using f, replacing each elided
+// stretch by elision.
+//
+// A change that both deletes and inserts text is rendered by f.Replace,
+// and one that only deletes or only inserts by f.Delete or f.Insert.
+func (d *WordDiff) Format(f Formatter, elision string) string {
+	var b strings.Builder
+	segs := d.Segments
+	for i := 0; i < len(segs); {
+		switch segs[i].Kind {
+		case Equal:
+			b.WriteString(f.Equal(segs[i]))
+			i++
+			continue
+		case Elided:
+			b.WriteString(elision)
+			i++
+			continue
+		}
+
+		// A change is a run of deletions followed by a run of insertions.
+		j := i
+		for j < len(segs) && segs[j].Kind == Delete {
+			j++
+		}
+		k := j
+		for k < len(segs) && segs[k].Kind == Insert {
+			k++
+		}
+		deleted, inserted := segs[i:j], segs[j:k]
+		for len(deleted) > 0 && len(inserted) > 0 {
+			b.WriteString(f.Replace(deleted[0], inserted[0]))
+			deleted, inserted = deleted[1:], inserted[1:]
+		}
+		for _, s := range deleted {
+			b.WriteString(f.Delete(s))
+		}
+		for _, s := range inserted {
+			b.WriteString(f.Insert(s))
+		}
+		i = k
+	}
+	return b.String()
 }
@@ -2,6 +2,7 @@ package diff
 
 import (
 	"fmt"
+	"math/rand"
 	"strings"
 	"testing"
 
@@ -24,41 +25,38 @@ func TestWordEdits(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			edits := Strings(test.before, test.after)
 			require.Equal(t, test.edits, edits)
-			edits, err := wordEdits(test.before, edits)
+			edits, err := WordEdits(test.before, edits, Spaces)
 			require.NoError(t, err)
 			require.Equal(t, test.wordEdits, edits)
 		})
 	}
 }
 
-func TestSplitWords(t *testing.T) {
+func TestSplitTokens(t *testing.T) {
 	tests := []struct {
 		content string
-		words   []string
+		tok     Tokenizer
+		tokens  []string
 	}{
-		{"a b c", []string{"a", "b", "c"}},
-		{"a\nb\nc", []string{"a\nb\nc"}},
+		{"a b c", Spaces, []string{"a ", "b ", "c"}},
+		{"a\nb\nc", Spaces, []string{"a\nb\nc"}},
+		{"a\nb\nc", Whitespace, []string{"a\n", "b\n", "c"}},
+		{"a\tb  c ", Whitespace, []string{"a\t", "b ", " ", "c "}},
+		{"a, b.c", Separators(" ,."), []string{"a,", " ", "b.", "c"}},
+		{"", Spaces, nil},
 	}
 
 	for _, test := range tests {
-		require.Equal(t, test.words, split(test.content))
+		require.Equal(t, test.tokens, splitTokens(test.content, test.tok))
 	}
 }
 
-var format = func(s string, delete bool) string {
+var format = FormatFunc(func(s string, delete bool) string {
 	if delete {
 		return fmt.Sprintf(`<span style="background-color=red">%s</span>`, s)
 	}
 	return fmt.Sprintf(`<span style="background-color=green">%s</span>`, s)
-}
-
-var split = func(text string) []string {
-	words := strings.Split(text, " ")
-	if words[len(words)-1] == "" {
-		words = words[:len(words)-1]
-	}
-	return words
-}
+})
 
 func TestUnifiedFunc(t *testing.T) {
 	tests := []struct {
@@ -88,10 +86,227 @@ func TestUnifiedFunc(t *testing.T) {
 		edits := Strings(test.before, test.after)
 		t.Log(edits)
 
-		unified, err := Unified(test.before, edits, split, format)
+		unified, err := Unified(test.before, edits, Spaces, format)
 		if err != nil {
 			t.Fatalf("Unified failed: %v", err)
 		}
 		require.Equal(t, test.expect, unified)
 	}
 }
+
+func TestUnifiedTokenizer(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after string
+		tok           Tokenizer
+		expect        string
+	}{
+		{
+			"tabs",
+			"id\tname\tcolour",
+			"id\tname\tcolor",
+			Whitespace,
+			"id\tname\t" + format("colour", true) + format("color", false),
+		},
+		{
+			"newlines",
+			"a\nb\nc",
+			"a\nx\nc",
+			Whitespace,
+			"a\n" + format("b", true) + format("x", false) + "\nc",
+		},
+		{
+			"punctuation",
+			"red,green,blue",
+			"red,amber,blue",
+			Separators(","),
+			`red,` + format("green", true) + format("amber", false) + `,blue`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			edits := Strings(test.before, test.after)
+			unified, err := Unified(test.before, edits, test.tok, format)
+			require.NoError(t, err)
+			require.Equal(t, test.expect, unified)
+		})
+	}
+}
+
+// strip returns a Formatter that keeps only deleted words (keep=true)
+// or only inserted words (keep=false), unformatted.
+func strip(keep bool) Formatter {
+	return FormatFunc(func(s string, delete bool) string {
+		if delete == keep {
+			return s
+		}
+		return ""
+	})
+}
+
+func TestUnifiedWhitespace(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after string
+		expect        string
+	}{
+		{
+			"runs of spaces",
+			"key  =   red  ",
+			"key  =   blue  ",
+			"key  =   " + format("red", true) + format("blue", false) + "  ",
+		},
+		{
+			"different boundaries",
+			"a b\nc",
+			"a x c",
+			"a " + format("b\n", true) + format("x ", false) + "c",
+		},
+		{
+			"deletion",
+			"a\tb\tc",
+			"a\tc",
+			"a\t" + format("b\t", true) + "c",
+		},
+		{
+			"distant changes",
+			"a b c d e f g h",
+			"x b c d e f g y",
+			format("a", true) + format("x", false) + " b c d e f g " + format("h", true) + format("y", false),
+		},
+		{
+			"insertion",
+			"a\n\nc\n",
+			"a\n\nb\nc\n",
+			"a\n\n" + format("b\n", false) + "c\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			edits := Strings(test.before, test.after)
+			unified, err := Unified(test.before, edits, Whitespace, format)
+			require.NoError(t, err)
+			require.Equal(t, test.expect, unified)
+
+			before, err := Unified(test.before, edits, Whitespace, strip(true))
+			require.NoError(t, err)
+			require.Equal(t, test.before, before)
+			after, err := Unified(test.before, edits, Whitespace, strip(false))
+			require.NoError(t, err)
+			require.Equal(t, test.after, after)
+		})
+	}
+}
+
+func TestUnifiedRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := func() string {
+		var b strings.Builder
+		for i := rng.Intn(12); i > 0; i-- {
+			b.WriteString([]string{"a", "b", "cc", "", " ", "  ", "\t", "\n"}[rng.Intn(8)])
+		}
+		return b.String()
+	}
+	for i := 0; i < 1000; i++ {
+		before, after := random(), random()
+		edits := Strings(before, after)
+		if len(edits) == 0 {
+			continue // Unified renders no change as ""
+		}
+		got, err := Unified(before, edits, Whitespace, strip(true))
+		require.NoError(t, err)
+		require.Equal(t, before, got, "before=%q after=%q", before, after)
+		got, err = Unified(before, edits, Whitespace, strip(false))
+		require.NoError(t, err)
+		require.Equal(t, after, got, "before=%q after=%q", before, after)
+
+		d, err := ToWordDiff(before, edits, Whitespace, 1)
+		require.NoError(t, err)
+		for _, s := range d.Segments {
+			if s.Kind != Insert {
+				require.Equal(t, s.Text, before[s.OldOffset:][:len(s.Text)], "%+v", s)
+			}
+			if s.Kind != Delete {
+				require.Equal(t, s.Text, after[s.NewOffset:][:len(s.Text)], "%+v", s)
+			}
+		}
+	}
+}
+
+func TestUnifiedContext(t *testing.T) {
+	before := "one two three four five six seven eight nine ten eleven twelve"
+	after := "one two three four five 6 seven eight nine ten eleven 12"
+	tests := []struct {
+		context int
+		expect  string
+	}{
+		{0, "..." + format("six", true) + format("6", false) + " ..." + format("twelve", true) + format("12", false)},
+		{1, "...five " + format("six", true) + format("6", false) + " seven ...eleven " + format("twelve", true) + format("12", false)},
+		{2, "...four five " + format("six", true) + format("6", false) + " seven eight ...ten eleven " + format("twelve", true) + format("12", false)},
+		{3, "...three four five " + format("six", true) + format("6", false) + " seven eight nine ten eleven " + format("twelve", true) + format("12", false)},
+		{FullContext, "one two three four five " + format("six", true) + format("6", false) + " seven eight nine ten eleven " + format("twelve", true) + format("12", false)},
+	}
+
+	edits := Strings(before, after)
+	for _, test := range tests {
+		got, err := UnifiedContext(before, edits, Spaces, format, test.context, "...")
+		require.NoError(t, err)
+		require.Equal(t, test.expect, got, "context=%d", test.context)
+	}
+
+	got, err := UnifiedContext("a b c d e", Strings("a b c d e", "a x c d e"), Spaces, format, 1, "…")
+	require.NoError(t, err)
+	require.Equal(t, "a "+format("b", true)+format("x", false)+" c …", got)
+}
+
+func TestToWordDiff(t *testing.T) {
+	before := "the red fox  jumped"
+	after := "the green fox  ran far"
+	d, err := ToWordDiff(before, Strings(before, after), Whitespace, FullContext)
+	require.NoError(t, err)
+	require.Equal(t, []Segment{
+		{Kind: Equal, Text: "the ", OldOffset: 0, NewOffset: 0, OldIndex: 0, NewIndex: 0},
+		{Kind: Delete, Text: "red", OldOffset: 4, NewOffset: 4, OldIndex: 1, NewIndex: 1},
+		{Kind: Insert, Text: "green", OldOffset: 7, NewOffset: 4, OldIndex: 2, NewIndex: 1},
+		{Kind: Equal, Text: " fox  ", OldOffset: 7, NewOffset: 9, OldIndex: 2, NewIndex: 2},
+		{Kind: Delete, Text: "jumped", OldOffset: 13, NewOffset: 15, OldIndex: 4, NewIndex: 4},
+		{Kind: Insert, Text: "ran far", OldOffset: 19, NewOffset: 15, OldIndex: 5, NewIndex: 4},
+	}, d.Segments)
+
+	d, err = ToWordDiff(before, Strings(before, after), Whitespace, 0)
+	require.NoError(t, err)
+	require.Equal(t, Segment{Kind: Elided, Text: "fox  ", OldOffset: 8, NewOffset: 10, OldIndex: 2, NewIndex: 2}, d.Segments[4])
+
+	d, err = ToWordDiff(before, nil, Whitespace, FullContext)
+	require.NoError(t, err)
+	require.Empty(t, d.Segments)
+}
+
+// tooltips is a Formatter that annotates each word with its position
+// and each replacement with the word it replaces.
+type tooltips struct{}
+
+func (tooltips) Equal(s Segment) string { return strings.ToUpper(s.Text) }
+func (tooltips) Delete(s Segment) string {
+	return fmt.Sprintf("[-%s@%d:%d-]", s.Text, s.OldOffset, s.OldIndex)
+}
+func (tooltips) Insert(s Segment) string {
+	return fmt.Sprintf("{+%s@%d:%d+}", s.Text, s.NewOffset, s.NewIndex)
+}
+func (tooltips) Replace(deleted, inserted Segment) string {
+	return fmt.Sprintf("{%s was: %s}", inserted.Text, deleted.Text)
+}
+
+func TestFormatter(t *testing.T) {
+	before := "a red fox jumped high"
+	after := "a blue fox ran"
+	got, err := Unified(before, Strings(before, after), Spaces, tooltips{})
+	require.NoError(t, err)
+	require.Equal(t, "A {blue was: red} FOX {ran was: jumped high}", got)
+
+	got, err = Unified(before, []Edit{{2, 6, ""}, {10, 17, ""}}, Spaces, tooltips{})
+	require.NoError(t, err)
+	require.Equal(t, "A [-red @2:1-]FOX [-jumped @10:3-]HIGH", got)
+}
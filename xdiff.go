@@ -0,0 +1,574 @@
+package diff
+
+import (
+	"math"
+	"strings"
+
+	"github.com/glaslos/diff/lcs"
+)
+
+// This file ports the parts of git's xdiff library that git diff
+// --word-diff uses to align the words of a changed run of lines: the
+// preparation of the records (xdiff/xprepare.c), Myers' algorithm with
+// its heuristics, and the compaction of the resulting changes
+// (xdiff/xdiffi.c), all with no flags set, after trim_common_tail from
+// xdiff-interface.c. Where several alignments are equally short, these
+// decide which one git shows, so they are reproduced exactly.
+
+// Constants of xdiff.
+const (
+	xdlMaxCostMin    = 256
+	xdlHeurMinCost   = 256
+	xdlSnakeCnt      = 20
+	xdlKHeur         = 4
+	xdlMaxEqLimit    = 1024
+	xdlSimscanWindow = 100
+	xdlKpdisRun      = 4
+	xdlLineMax       = math.MaxInt
+	xdlTailBlock     = 1024 // block size of trim_common_tail
+)
+
+// An xdfile is a sequence of records being diffed, as in xdiff's
+// xdfile_t. The records are identified by their equivalence class.
+type xdfile struct {
+	class        []int  // equivalence class of each record
+	dstart, dend int    // records outside [dstart, dend] are common to both
+	rchg         []bool // whether each record is changed, from index 1
+	rindex       []int  // indexes of the records given to the search
+	ha           []int  // their classes
+}
+
+func (f *xdfile) changed(i int) bool       { return f.rchg[i+1] }
+func (f *xdfile) setChanged(i int, c bool) { f.rchg[i+1] = c }
+
+// xdiffRecords returns the differences between the records a and b,
+// each a word without its newline, as found by git's xdiff with no
+// flags set.
+func xdiffRecords(a, b []string) []lcs.Diff {
+	a, b = trimCommonTail(a, b)
+
+	// Classify the records, counting the occurrences of each class.
+	classes := make(map[string]int)
+	var count1, count2 []int
+	classify := func(recs []string) *xdfile {
+		f := &xdfile{class: make([]int, len(recs)), rchg: make([]bool, len(recs)+2)}
+		for i, r := range recs {
+			c, ok := classes[r]
+			if !ok {
+				c = len(classes)
+				classes[r] = c
+				count1, count2 = append(count1, 0), append(count2, 0)
+			}
+			f.class[i] = c
+		}
+		return f
+	}
+	f1, f2 := classify(a), classify(b)
+	for _, c := range f1.class {
+		count1[c]++
+	}
+	for _, c := range f2.class {
+		count2[c]++
+	}
+
+	xdlTrimEnds(f1, f2)
+	xdlCleanupRecords(f1, count2)
+	xdlCleanupRecords(f2, count1)
+
+	ndiags := len(f1.ha) + len(f2.ha) + 3
+	s := &xdsearch{
+		f1:     f1,
+		f2:     f2,
+		kvd:    make([]int, 2*ndiags+2),
+		foff:   len(f2.ha) + 1,
+		boff:   ndiags + len(f2.ha) + 1,
+		mxcost: max(xdlBogosqrt(ndiags), xdlMaxCostMin),
+	}
+	s.recsCmp(0, len(f1.ha), 0, len(f2.ha), false)
+
+	xdlChangeCompact(f1, f2)
+	xdlChangeCompact(f2, f1)
+	return xdlBuildScript(f1, f2)
+}
+
+// trimCommonTail drops from a and b the records in the blocks of 1024
+// bytes at the ends of their files, with a newline after each record,
+// that are the same in both, except for the part of those blocks up to
+// the first newline, as trim_common_tail does.
+func trimCommonTail(a, b []string) ([]string, []string) {
+	fa, fb := strings.Join(a, "\n")+"\n", strings.Join(b, "\n")+"\n"
+	if len(a) == 0 {
+		fa = ""
+	}
+	if len(b) == 0 {
+		fb = ""
+	}
+	trimmed := 0
+	smaller := min(len(fa), len(fb))
+	for xdlTailBlock+trimmed <= smaller &&
+		fa[len(fa)-trimmed-xdlTailBlock:len(fa)-trimmed] == fb[len(fb)-trimmed-xdlTailBlock:len(fb)-trimmed] {
+		trimmed += xdlTailBlock
+	}
+	recovered := trimmed
+	if i := strings.IndexByte(fa[len(fa)-trimmed:], '\n'); i >= 0 {
+		recovered = i + 1
+	}
+	n := strings.Count(fa[len(fa)-(trimmed-recovered):], "\n")
+	return a[:len(a)-n], b[:len(b)-n]
+}
+
+// xdlBogosqrt approximates the square root of n, as xdl_bogosqrt does.
+func xdlBogosqrt(n int) int {
+	i := 1
+	for ; n > 0; n >>= 2 {
+		i <<= 1
+	}
+	return i
+}
+
+// xdlTrimEnds sets the range of records of f1 and f2 that lies between
+// their common prefix and suffix, as xdl_trim_ends does.
+func xdlTrimEnds(f1, f2 *xdfile) {
+	n1, n2 := len(f1.class), len(f2.class)
+	lim := min(n1, n2)
+	i := 0
+	for i < lim && f1.class[i] == f2.class[i] {
+		i++
+	}
+	f1.dstart, f2.dstart = i, i
+	j := 0
+	for lim -= i; j < lim && f1.class[n1-1-j] == f2.class[n2-1-j]; j++ {
+	}
+	f1.dend, f2.dend = n1-j-1, n2-j-1
+}
+
+// xdlCleanupRecords marks as changed the records of f between dstart
+// and dend that do not occur in the other file, whose class counts are
+// other, and those that occur in it too often and lie among such
+// records, and passes the rest to the search, as xdl_cleanup_records
+// does.
+func xdlCleanupRecords(f *xdfile, other []int) {
+	mlim := min(xdlBogosqrt(len(f.class)), xdlMaxEqLimit)
+	dis := make([]byte, len(f.class))
+	for i := f.dstart; i <= f.dend; i++ {
+		switch nm := other[f.class[i]]; {
+		case nm == 0:
+			dis[i] = 0
+		case nm >= mlim:
+			dis[i] = 2
+		default:
+			dis[i] = 1
+		}
+	}
+	for i := f.dstart; i <= f.dend; i++ {
+		if dis[i] == 1 || dis[i] == 2 && !xdlCleanMmatch(dis, i, f.dstart, f.dend) {
+			f.rindex = append(f.rindex, i)
+			f.ha = append(f.ha, f.class[i])
+		} else {
+			f.setChanged(i, true)
+		}
+	}
+}
+
+// xdlCleanMmatch reports whether the record i, which occurs too often
+// in the other file, should be discarded because it lies in a run of
+// such records and records that do not occur in the other file at all,
+// mostly the latter, as xdl_clean_mmatch does.
+func xdlCleanMmatch(dis []byte, i, s, e int) bool {
+	if i-s > xdlSimscanWindow {
+		s = i - xdlSimscanWindow
+	}
+	if e-i > xdlSimscanWindow {
+		e = i + xdlSimscanWindow
+	}
+	rdis0, rpdis0 := 0, 1
+	for r := 1; i-r >= s; r++ {
+		if dis[i-r] == 0 {
+			rdis0++
+		} else if dis[i-r] == 2 {
+			rpdis0++
+		} else {
+			break
+		}
+	}
+	if rdis0 == 0 {
+		return false
+	}
+	rdis1, rpdis1 := 0, 1
+	for r := 1; i+r <= e; r++ {
+		if dis[i+r] == 0 {
+			rdis1++
+		} else if dis[i+r] == 2 {
+			rpdis1++
+		} else {
+			break
+		}
+	}
+	if rdis1 == 0 {
+		return false
+	}
+	rdis1 += rdis0
+	rpdis1 += rpdis0
+	return rpdis1*xdlKpdisRun < rpdis1+rdis1
+}
+
+// An xdsearch is the state of xdiff's Myers search over the records
+// given to it by xdlCleanupRecords.
+type xdsearch struct {
+	f1, f2     *xdfile
+	kvd        []int // forward and backward furthest reaching paths
+	foff, boff int   // indexes in kvd of diagonal 0 of each
+	mxcost     int   // cost beyond which a split is forced
+}
+
+// An xdsplit is where xdl_split divides the search, and whether each
+// half must be searched for a minimal diff.
+type xdsplit struct {
+	i1, i2       int
+	minLo, minHi bool
+}
+
+// recsCmp marks the changed records among ha1[off1:lim1] and
+// ha2[off2:lim2], as xdl_recs_cmp does.
+func (s *xdsearch) recsCmp(off1, lim1, off2, lim2 int, needMin bool) {
+	ha1, ha2 := s.f1.ha, s.f2.ha
+	for off1 < lim1 && off2 < lim2 && ha1[off1] == ha2[off2] {
+		off1, off2 = off1+1, off2+1
+	}
+	for off1 < lim1 && off2 < lim2 && ha1[lim1-1] == ha2[lim2-1] {
+		lim1, lim2 = lim1-1, lim2-1
+	}
+	switch {
+	case off1 == lim1:
+		for ; off2 < lim2; off2++ {
+			s.f2.setChanged(s.f2.rindex[off2], true)
+		}
+	case off2 == lim2:
+		for ; off1 < lim1; off1++ {
+			s.f1.setChanged(s.f1.rindex[off1], true)
+		}
+	default:
+		spl := s.split(off1, lim1, off2, lim2, needMin)
+		s.recsCmp(off1, spl.i1, off2, spl.i2, spl.minLo)
+		s.recsCmp(spl.i1, lim1, spl.i2, lim2, spl.minHi)
+	}
+}
+
+// split finds where to divide the search of ha1[off1:lim1] and
+// ha2[off2:lim2]: the middle snake of a minimal path, or, when the
+// search grows too costly, a point on a good path, as xdl_split does.
+func (s *xdsearch) split(off1, lim1, off2, lim2 int, needMin bool) xdsplit {
+	ha1, ha2 := s.f1.ha, s.f2.ha
+	kvdf := func(d int) *int { return &s.kvd[s.foff+d] }
+	kvdb := func(d int) *int { return &s.kvd[s.boff+d] }
+
+	dmin, dmax := off1-lim2, lim1-off2
+	fmid, bmid := off1-off2, lim1-lim2
+	odd := (fmid-bmid)&1 != 0
+	fmin, fmax := fmid, fmid
+	bmin, bmax := bmid, bmid
+
+	*kvdf(fmid) = off1
+	*kvdb(bmid) = lim1
+
+	for ec := 1; ; ec++ {
+		gotSnake := false
+
+		// Extend the forward paths.
+		if fmin > dmin {
+			fmin--
+			*kvdf(fmin - 1) = -1
+		} else {
+			fmin++
+		}
+		if fmax < dmax {
+			fmax++
+			*kvdf(fmax + 1) = -1
+		} else {
+			fmax--
+		}
+		for d := fmax; d >= fmin; d -= 2 {
+			var i1 int
+			if *kvdf(d - 1) >= *kvdf(d + 1) {
+				i1 = *kvdf(d - 1) + 1
+			} else {
+				i1 = *kvdf(d + 1)
+			}
+			prev1 := i1
+			i2 := i1 - d
+			for i1 < lim1 && i2 < lim2 && ha1[i1] == ha2[i2] {
+				i1, i2 = i1+1, i2+1
+			}
+			if i1-prev1 > xdlSnakeCnt {
+				gotSnake = true
+			}
+			*kvdf(d) = i1
+			if odd && bmin <= d && d <= bmax && *kvdb(d) <= i1 {
+				return xdsplit{i1, i2, true, true}
+			}
+		}
+
+		// Extend the backward paths.
+		if bmin > dmin {
+			bmin--
+			*kvdb(bmin - 1) = xdlLineMax
+		} else {
+			bmin++
+		}
+		if bmax < dmax {
+			bmax++
+			*kvdb(bmax + 1) = xdlLineMax
+		} else {
+			bmax--
+		}
+		for d := bmax; d >= bmin; d -= 2 {
+			var i1 int
+			if *kvdb(d - 1) < *kvdb(d + 1) {
+				i1 = *kvdb(d - 1)
+			} else {
+				i1 = *kvdb(d + 1) - 1
+			}
+			prev1 := i1
+			i2 := i1 - d
+			for i1 > off1 && i2 > off2 && ha1[i1-1] == ha2[i2-1] {
+				i1, i2 = i1-1, i2-1
+			}
+			if prev1-i1 > xdlSnakeCnt {
+				gotSnake = true
+			}
+			*kvdb(d) = i1
+			if !odd && fmin <= d && d <= fmax && i1 <= *kvdf(d) {
+				return xdsplit{i1, i2, true, true}
+			}
+		}
+
+		if needMin {
+			continue
+		}
+
+		// If the cost is high and there is a long snake, split at a
+		// path that has got far from the corner without straying far
+		// from the middle diagonal, if one ends in a snake.
+		if gotSnake && ec > xdlHeurMinCost {
+			best := 0
+			var spl xdsplit
+			for d := fmax; d >= fmin; d -= 2 {
+				dd := abs(d - fmid)
+				i1 := *kvdf(d)
+				i2 := i1 - d
+				v := (i1 - off1) + (i2 - off2) - dd
+				if v > xdlKHeur*ec && v > best &&
+					off1+xdlSnakeCnt <= i1 && i1 < lim1 &&
+					off2+xdlSnakeCnt <= i2 && i2 < lim2 {
+					for k := 1; ha1[i1-k] == ha2[i2-k]; k++ {
+						if k == xdlSnakeCnt {
+							best = v
+							spl = xdsplit{i1, i2, true, false}
+							break
+						}
+					}
+				}
+			}
+			if best > 0 {
+				return spl
+			}
+			for d := bmax; d >= bmin; d -= 2 {
+				dd := abs(d - bmid)
+				i1 := *kvdb(d)
+				i2 := i1 - d
+				v := (lim1 - i1) + (lim2 - i2) - dd
+				if v > xdlKHeur*ec && v > best &&
+					off1 < i1 && i1 <= lim1-xdlSnakeCnt &&
+					off2 < i2 && i2 <= lim2-xdlSnakeCnt {
+					for k := 0; ha1[i1+k] == ha2[i2+k]; k++ {
+						if k == xdlSnakeCnt-1 {
+							best = v
+							spl = xdsplit{i1, i2, false, true}
+							break
+						}
+					}
+				}
+			}
+			if best > 0 {
+				return spl
+			}
+		}
+
+		// Enough is enough: split at the furthest reaching path.
+		if ec >= s.mxcost {
+			fbest, fbest1 := -1, -1
+			for d := fmax; d >= fmin; d -= 2 {
+				i1 := min(*kvdf(d), lim1)
+				i2 := i1 - d
+				if lim2 < i2 {
+					i1, i2 = lim2+d, lim2
+				}
+				if fbest < i1+i2 {
+					fbest, fbest1 = i1+i2, i1
+				}
+			}
+			bbest, bbest1 := xdlLineMax, xdlLineMax
+			for d := bmax; d >= bmin; d -= 2 {
+				i1 := max(off1, *kvdb(d))
+				i2 := i1 - d
+				if i2 < off2 {
+					i1, i2 = off2+d, off2
+				}
+				if i1+i2 < bbest {
+					bbest, bbest1 = i1+i2, i1
+				}
+			}
+			if (lim1+lim2)-bbest < fbest-(off1+off2) {
+				return xdsplit{fbest1, fbest - fbest1, true, false}
+			}
+			return xdsplit{bbest1, bbest - bbest1, false, true}
+		}
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// An xdgroup is a run of changed records, f.rchg[start:end], possibly
+// empty, as in xdiff's struct xdlgroup.
+type xdgroup struct{ start, end int }
+
+func groupInit(f *xdfile) xdgroup {
+	g := xdgroup{}
+	for f.changed(g.end) {
+		g.end++
+	}
+	return g
+}
+
+// groupNext moves g to the next group, reporting false at the end.
+func groupNext(f *xdfile, g *xdgroup) bool {
+	if g.end == len(f.class) {
+		return false
+	}
+	g.start = g.end + 1
+	for g.end = g.start; f.changed(g.end); g.end++ {
+	}
+	return true
+}
+
+// groupPrevious moves g to the previous group, reporting false at the
+// start.
+func groupPrevious(f *xdfile, g *xdgroup) bool {
+	if g.start == 0 {
+		return false
+	}
+	g.end = g.start - 1
+	for g.start = g.end; f.changed(g.start - 1); g.start-- {
+	}
+	return true
+}
+
+// groupSlideDown moves g down by one record, merging it with the group
+// below if they meet, if the record after it equals its first record.
+func groupSlideDown(f *xdfile, g *xdgroup) bool {
+	if g.end < len(f.class) && f.class[g.start] == f.class[g.end] {
+		f.setChanged(g.start, false)
+		f.setChanged(g.end, true)
+		g.start, g.end = g.start+1, g.end+1
+		for f.changed(g.end) {
+			g.end++
+		}
+		return true
+	}
+	return false
+}
+
+// groupSlideUp moves g up by one record, merging it with the group
+// above if they meet, if the record before it equals its last record.
+func groupSlideUp(f *xdfile, g *xdgroup) bool {
+	if g.start > 0 && f.class[g.start-1] == f.class[g.end-1] {
+		g.start, g.end = g.start-1, g.end-1
+		f.setChanged(g.start, true)
+		f.setChanged(g.end, false)
+		for f.changed(g.start - 1) {
+			g.start--
+		}
+		return true
+	}
+	return false
+}
+
+// xdlChangeCompact slides each group of changed records of f as far
+// down as it will go, merging the groups it meets, and then back up to
+// the last position at which it lines up with a group of changes in
+// the other file fo, if any, as xdl_change_compact does with no flags.
+func xdlChangeCompact(f, fo *xdfile) {
+	g, og := groupInit(f), groupInit(fo)
+	for {
+		if g.end != g.start {
+			var earliestEnd, endMatchingOther int
+			for {
+				groupsize := g.end - g.start
+				endMatchingOther = -1
+				for groupSlideUp(f, &g) {
+					if !groupPrevious(fo, &og) {
+						panic("group sync broken sliding up")
+					}
+				}
+				earliestEnd = g.end
+				if og.end > og.start {
+					endMatchingOther = g.end
+				}
+				for groupSlideDown(f, &g) {
+					if !groupNext(fo, &og) {
+						panic("group sync broken sliding down")
+					}
+					if og.end > og.start {
+						endMatchingOther = g.end
+					}
+				}
+				if groupsize == g.end-g.start {
+					break
+				}
+			}
+			if g.end != earliestEnd && endMatchingOther != -1 {
+				for og.end == og.start {
+					if !groupSlideUp(f, &g) {
+						panic("match disappeared")
+					}
+					if !groupPrevious(fo, &og) {
+						panic("group sync broken sliding to match")
+					}
+				}
+			}
+		}
+		if !groupNext(f, &g) {
+			break
+		}
+		if !groupNext(fo, &og) {
+			panic("group sync broken moving to next group")
+		}
+	}
+}
+
+// xdlBuildScript returns the changes marked in f1 and f2, pairing their
+// groups of changed records, as xdl_build_script does.
+func xdlBuildScript(f1, f2 *xdfile) []lcs.Diff {
+	var diffs []lcs.Diff
+	for i1, i2 := len(f1.class), len(f2.class); i1 >= 0 || i2 >= 0; i1, i2 = i1-1, i2-1 {
+		if f1.changed(i1-1) || f2.changed(i2-1) {
+			l1, l2 := i1, i2
+			for f1.changed(i1 - 1) {
+				i1--
+			}
+			for f2.changed(i2 - 1) {
+				i2--
+			}
+			diffs = append(diffs, lcs.Diff{Start: i1, End: l1, ReplStart: i2, ReplEnd: l2})
+		}
+	}
+	for i, j := 0, len(diffs)-1; i < j; i, j = i+1, j-1 {
+		diffs[i], diffs[j] = diffs[j], diffs[i]
+	}
+	return diffs
+}